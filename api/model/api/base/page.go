@@ -14,12 +14,32 @@
 
 package base
 
-import "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/utils"
+import (
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/utils"
+)
+
+const (
+	FolderPageType   = "FOLDER"
+	MarkdownPageType = "MARKDOWN"
+	AsciidocPageType = "ASCIIDOC"
+	SwaggerPageType  = "SWAGGER"
+	AsyncAPIPageType = "ASYNCAPI"
+)
 
 type PageSource struct {
 	// +kubebuilder:validation:Required
+	// The type of fetcher used to fetch the page content (e.g. `http-fetcher`).
+	//
+	// Sources of type `service-fetcher` are resolved by the operator, which fetches the page content
+	// from a service of the cluster and syncs the API again each time the content changes.
+	// Their configuration holds the `serviceName`, the `port` (defaults to 80), the `path` (defaults to `/openapi.json`)
+	// and the `fetchInterval` (a duration such as `10m`, defaults to `5m`) of the document.
+	// The service must run in the namespace of the API, and documents larger than 5MiB are rejected.
+	// Their content is not fetched when the API is validated by the admission webhook.
 	Type string `json:"type"`
 	// +kubebuilder:validation:Required
+	// The configuration of the fetcher
 	Configuration *utils.GenericStringMap `json:"configuration"`
 }
 
@@ -58,6 +78,18 @@ type Page struct {
 	// The content of the page, if any.
 	Content string `json:"content,omitempty"`
 	// +kubebuilder:validation:Optional
+	// The content of the page, read from a config map or a secret key.
+	// When set, it takes precedence over the inline content and the API is synced again
+	// each time the config map or the secret changes.
+	ContentFrom *refs.ValueFrom `json:"contentFrom,omitempty"`
+	// +kubebuilder:validation:Optional
+	// A config map expanded into a tree of pages under this folder, each key being the path of a page.
+	// Path segments are separated by a double underscore (e.g. `guides__getting-started.md`)
+	// and the type of each page is derived from the extension of its key (md, adoc, json, yaml or yml).
+	// Generated pages inherit the visibility and the publication of the folder.
+	// Only folders can be expanded, and the config map must be in the namespace of the API.
+	PagesFrom *refs.NamespacedName `json:"pagesFrom,omitempty"`
+	// +kubebuilder:validation:Optional
 	// The order used to display the page in APIM and on the portal.
 	Order uint64 `json:"order"`
 	// +kubebuilder:validation:Optional
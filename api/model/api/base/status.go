@@ -17,6 +17,15 @@ package base
 import (
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/status"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConditionCategoriesResolved reports whether the categories referenced by the API exist in APIM.
+	ConditionCategoriesResolved = "CategoriesResolved"
+
+	ReasonCategoriesFound  = "CategoriesFound"
+	ReasonCategoryNotFound = "CategoryNotFound"
 )
 
 type Status struct {
@@ -39,4 +48,10 @@ type Status struct {
 	// When API has been created regardless of errors, this field is
 	// used to persist the error message encountered during admission
 	Errors status.Errors `json:"errors,omitempty"`
+	// The latest observations of the API state, used to report issues
+	// that prevent the API from being synced with APIM.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
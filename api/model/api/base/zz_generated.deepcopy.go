@@ -22,6 +22,7 @@ package base
 
 import (
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -369,6 +370,16 @@ func (in *PKCS12TrustStore) DeepCopy() *PKCS12TrustStore {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Page) DeepCopyInto(out *Page) {
 	*out = *in
+	if in.ContentFrom != nil {
+		in, out := &in.ContentFrom, &out.ContentFrom
+		*out = new(refs.ValueFrom)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PagesFrom != nil {
+		in, out := &in.PagesFrom, &out.PagesFrom
+		*out = new(refs.NamespacedName)
+		**out = **in
+	}
 	if in.Source != nil {
 		in, out := &in.Source, &out.Source
 		*out = new(PageSource)
@@ -588,6 +599,13 @@ func (in *Status) DeepCopyInto(out *Status) {
 		}
 	}
 	in.Errors.DeepCopyInto(&out.Errors)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Status.
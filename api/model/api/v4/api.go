@@ -26,6 +26,11 @@ import (
 // +kubebuilder:validation:Enum=PROXY;MESSAGE;
 type ApiType string
 
+const (
+	ProxyType   = ApiType("PROXY")
+	MessageType = ApiType("MESSAGE")
+)
+
 // +kubebuilder:validation:Enum=PUBLISHED;UNPUBLISHED;
 type ApiV4LifecycleState string
 
@@ -50,13 +55,13 @@ type Api struct {
 	// +kubebuilder:validation:Required
 	// Api Type (proxy or message)
 	Type ApiType `json:"type"`
-	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:MinItems:=1
-	// List of listeners for this API
+	// +kubebuilder:validation:Optional
+	// List of listeners for this API.
+	// At least one listener is required unless listeners are generated from an OpenAPI specification.
 	Listeners []*GenericListener `json:"listeners"`
-	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:MinItems:=1
-	// List of Endpoint groups
+	// +kubebuilder:validation:Optional
+	// List of Endpoint groups.
+	// At least one endpoint group is required unless endpoint groups are generated from an OpenAPI specification.
 	EndpointGroups []*EndpointGroup `json:"endpointGroups"`
 	// A map of plan identifiers to plan
 	// Keys uniquely identify plans and are used to keep them in sync
@@ -85,6 +90,14 @@ type Api struct {
 	// Renaming a key is the equivalent of deleting the page and recreating
 	// it holding a new ID in APIM.
 	Pages map[string]*Page `json:"pages"`
+	// +kubebuilder:validation:Optional
+	// An OpenAPI specification the API is generated from.
+	//
+	// Missing listeners and endpoint groups are derived from the servers of the specification
+	// and from the configured backend, each operation becomes a flow with an HTTP selector
+	// and the specification is attached to the API as a SWAGGER page.
+	// Flows and pages declared in the API take precedence over the generated ones.
+	OpenAPI *OpenAPI `json:"openAPI,omitempty"`
 }
 
 type GatewayDefinitionApi struct {
@@ -223,6 +236,17 @@ func (api *Api) getApiDefinitionFlows() []*Flow {
 	return flows
 }
 
+// GetAllFlows returns the flows of the API followed by the flows of its plans.
+func (api *Api) GetAllFlows() []*Flow {
+	flows := append([]*Flow{}, api.Flows...)
+	for _, plan := range api.Plans {
+		if plan != nil {
+			flows = append(flows, plan.Flows...)
+		}
+	}
+	return flows
+}
+
 func (api *Api) getGatewayDefinitionEndpointGroups() []*EndpointGroup {
 	endpointGroups := make([]*EndpointGroup, len(api.EndpointGroups))
 	for i, endpointGroup := range api.EndpointGroups {
@@ -0,0 +1,140 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v4
+
+import (
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+)
+
+// ContextTarget references a management context the API is deployed to,
+// along with the values overriding the API definition in this context only.
+type ContextTarget struct {
+	refs.NamespacedName `json:",inline"`
+	// +kubebuilder:validation:Optional
+	// Endpoint groups replacing the endpoint groups of the API holding the same name.
+	// Groups that are not declared by the API are added to it.
+	EndpointGroups []*EndpointGroup `json:"endpointGroups,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Properties replacing the properties of the API holding the same key.
+	// Properties that are not declared by the API are added to it.
+	Properties []*base.Property `json:"properties,omitempty"`
+	// +kubebuilder:validation:Optional
+	// The keys of the plans that must not be deployed in this context.
+	DisabledPlans []string `json:"disabledPlans,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=STARTED;STOPPED;
+	// The state of the API in this context, overriding the state of the API.
+	State base.ApiState `json:"state,omitempty"`
+}
+
+// ApplyTo overrides the definition of the API with the values of the context.
+func (target *ContextTarget) ApplyTo(api *Api) {
+	if len(target.EndpointGroups) > 0 {
+		api.EndpointGroups = mergeEndpointGroups(api.EndpointGroups, target.EndpointGroups)
+	}
+
+	if len(target.Properties) > 0 && api.ApiBase != nil {
+		api.Properties = mergeProperties(api.Properties, target.Properties)
+	}
+
+	for _, key := range target.DisabledPlans {
+		delete(api.Plans, key)
+	}
+
+	if target.State != "" && api.ApiBase != nil {
+		api.State = target.State
+	}
+}
+
+func mergeEndpointGroups(groups, overrides []*EndpointGroup) []*EndpointGroup {
+	merged := make([]*EndpointGroup, 0, len(groups)+len(overrides))
+	replaced := make(map[string]bool, len(overrides))
+	for _, group := range groups {
+		if override := findEndpointGroup(overrides, group.Name); override != nil {
+			merged = append(merged, override.DeepCopy())
+			replaced[group.Name] = true
+			continue
+		}
+		merged = append(merged, group)
+	}
+	for _, override := range overrides {
+		if !replaced[override.Name] {
+			merged = append(merged, override.DeepCopy())
+		}
+	}
+	return merged
+}
+
+func findEndpointGroup(groups []*EndpointGroup, name string) *EndpointGroup {
+	for _, group := range groups {
+		if group.Name == name {
+			return group
+		}
+	}
+	return nil
+}
+
+func mergeProperties(properties, overrides []*base.Property) []*base.Property {
+	merged := make([]*base.Property, 0, len(properties)+len(overrides))
+	replaced := make(map[string]bool, len(overrides))
+	for _, property := range properties {
+		if override := findProperty(overrides, property.Key); override != nil {
+			merged = append(merged, override.DeepCopy())
+			replaced[property.Key] = true
+			continue
+		}
+		merged = append(merged, property)
+	}
+	for _, override := range overrides {
+		if !replaced[override.Key] {
+			merged = append(merged, override.DeepCopy())
+		}
+	}
+	return merged
+}
+
+func findProperty(properties []*base.Property, key string) *base.Property {
+	for _, property := range properties {
+		if property.Key == key {
+			return property
+		}
+	}
+	return nil
+}
+
+// ContextStatus is the observed state of the API in one of the management contexts it is deployed to.
+type ContextStatus struct {
+	// The management context the API is deployed to, formatted as namespace/name,
+	// or as /name for a cluster management context
+	Context string `json:"contextRef"`
+	// The organization ID of the management context
+	OrgID string `json:"organizationId,omitempty"`
+	// The environment ID of the management context
+	EnvID string `json:"environmentId,omitempty"`
+	// The ID of the API definition in this context
+	ID string `json:"id,omitempty"`
+	// The Cross ID of the API definition, shared by all contexts
+	CrossID string `json:"crossId,omitempty"`
+	// The state of the API in this context
+	State base.ApiState `json:"state,omitempty"`
+	// The IDs of the plans deployed in this context, by plan key
+	Plans map[string]string `json:"plans,omitempty"`
+	// The processing status of the API in this context
+	ProcessingStatus core.ProcessingStatus `json:"processingStatus,omitempty"`
+	// The error that prevented the API from being synced in this context, if any
+	Error string `json:"error,omitempty"`
+}
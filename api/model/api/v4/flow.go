@@ -16,6 +16,7 @@ package v4
 
 import (
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/utils"
 )
 
@@ -64,6 +65,26 @@ func NewFlow(name string) *Flow {
 	}
 }
 
+// +kubebuilder:validation:Enum=REQUEST;RESPONSE;PUBLISH;SUBSCRIBE;
+type FlowPhase string
+
+const (
+	RequestPhase   = FlowPhase("REQUEST")
+	ResponsePhase  = FlowPhase("RESPONSE")
+	PublishPhase   = FlowPhase("PUBLISH")
+	SubscribePhase = FlowPhase("SUBSCRIBE")
+)
+
+// StepsByPhase returns the steps of the flow, grouped by the phase they are executed in.
+func (fl *Flow) StepsByPhase() map[FlowPhase][]*FlowStep {
+	return map[FlowPhase][]*FlowStep{
+		RequestPhase:   fl.Request,
+		ResponsePhase:  fl.Response,
+		PublishPhase:   fl.Publish,
+		SubscribePhase: fl.Subscribe,
+	}
+}
+
 func (fl Flow) ToGatewayDefinition() *Flow {
 	for i := range fl.Selectors {
 		fl.Selectors[i] = fl.Selectors[i].ToGatewayDefinition()
@@ -71,10 +92,21 @@ func (fl Flow) ToGatewayDefinition() *Flow {
 	return &fl
 }
 
+const (
+	SharedPolicyGroupPolicy   = "shared-policy-group-policy"
+	SharedPolicyGroupIDConfig = "sharedPolicyGroupId"
+)
+
 type FlowStep struct {
 	base.FlowStep `json:",inline"`
 	// The message condition (supports EL expressions)
 	MessageCondition string `json:"messageCondition,omitempty"`
+	// Reference to a SharedPolicyGroup resource executed by this step,
+	// in which case the policy and configuration of the step are set by the operator.
+	// If the namespace is omitted, the namespace of the API is used.
+	// The group must be synced with each management context the API is synced with.
+	// +kubebuilder:validation:Optional
+	SharedPolicyGroup *refs.NamespacedName `json:"sharedPolicyGroupRef,omitempty"`
 }
 
 func NewFlowStep(base base.FlowStep) *FlowStep {
@@ -88,6 +120,16 @@ func (step *FlowStep) WithMessageCondition(messageCondition string) *FlowStep {
 	return step
 }
 
+func (step *FlowStep) HasSharedPolicyGroupRef() bool {
+	return step.SharedPolicyGroup != nil
+}
+
+// UseSharedPolicyGroup makes the step execute the shared policy group with the given APIM ID.
+func (step *FlowStep) UseSharedPolicyGroup(groupID string) {
+	step.Policy = SharedPolicyGroupPolicy
+	step.Configuration = utils.NewGenericStringMap().Put(SharedPolicyGroupIDConfig, groupID)
+}
+
 type FlowMode string
 
 const (
@@ -0,0 +1,39 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v4
+
+import (
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+)
+
+type OpenAPI struct {
+	// +kubebuilder:validation:Required
+	// The OpenAPI specification (2.0 or 3.x, JSON or YAML) the API is generated from,
+	// read from a config map or a secret key. The API is synced again each time the specification changes.
+	From *refs.ValueFrom `json:"from"`
+	// +kubebuilder:validation:Optional
+	// The URL of the backend targeted by the generated HTTP proxy endpoint.
+	// This is required unless endpoint groups are declared in the API.
+	Backend string `json:"backend,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=false
+	// If true, an OpenAPI specification validation policy is added to the request phase
+	// of each flow generated from the operations of the specification.
+	ValidateRequest bool `json:"validateRequest"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=`openapi`
+	// The key of the SWAGGER page holding the specification in the pages of the API.
+	PageKey string `json:"pageKey,omitempty"`
+}
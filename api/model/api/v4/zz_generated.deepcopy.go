@@ -22,6 +22,7 @@ package v4
 
 import (
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -211,6 +212,11 @@ func (in *Api) DeepCopyInto(out *Api) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.OpenAPI != nil {
+		in, out := &in.OpenAPI, &out.OpenAPI
+		*out = new(OpenAPI)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Api.
@@ -243,6 +249,71 @@ func (in *ApiServices) DeepCopy() *ApiServices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContextStatus) DeepCopyInto(out *ContextStatus) {
+	*out = *in
+	if in.Plans != nil {
+		in, out := &in.Plans, &out.Plans
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContextStatus.
+func (in *ContextStatus) DeepCopy() *ContextStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContextStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContextTarget) DeepCopyInto(out *ContextTarget) {
+	*out = *in
+	out.NamespacedName = in.NamespacedName
+	if in.EndpointGroups != nil {
+		in, out := &in.EndpointGroups, &out.EndpointGroups
+		*out = make([]*EndpointGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(EndpointGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make([]*base.Property, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(base.Property)
+				**out = **in
+			}
+		}
+	}
+	if in.DisabledPlans != nil {
+		in, out := &in.DisabledPlans, &out.DisabledPlans
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContextTarget.
+func (in *ContextTarget) DeepCopy() *ContextTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ContextTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DLQ) DeepCopyInto(out *DLQ) {
 	*out = *in
@@ -545,6 +616,11 @@ func (in *FlowSelector) DeepCopy() *FlowSelector {
 func (in *FlowStep) DeepCopyInto(out *FlowStep) {
 	*out = *in
 	in.FlowStep.DeepCopyInto(&out.FlowStep)
+	if in.SharedPolicyGroup != nil {
+		in, out := &in.SharedPolicyGroup, &out.SharedPolicyGroup
+		*out = new(refs.NamespacedName)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowStep.
@@ -753,6 +829,26 @@ func (in *LoggingPhase) DeepCopy() *LoggingPhase {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenAPI) DeepCopyInto(out *OpenAPI) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = new(refs.ValueFrom)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenAPI.
+func (in *OpenAPI) DeepCopy() *OpenAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Page) DeepCopyInto(out *Page) {
 	*out = *in
@@ -0,0 +1,43 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +kubebuilder:object:generate=true
+package category
+
+import "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+
+type Type struct {
+	// Category ID. If empty, the category is created and the sync fails if a category with the same name exists.
+	// Setting the ID of an existing category makes the resource manage it, including its deletion.
+	ID string `json:"id,omitempty"`
+	// Category name. APIs reference the category either by its name or by the key derived from it.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Category description
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+	// The position of the category in the portal, categories being sorted in ascending order
+	// +kubebuilder:validation:Optional
+	Order int `json:"order,omitempty"`
+	// If true, the category is not displayed in the portal
+	// +kubebuilder:validation:Optional
+	Hidden bool `json:"hidden,omitempty"`
+	// The ID of the documentation page highlighted on the category page of the portal
+	// +kubebuilder:validation:Optional
+	HighlightedPage string `json:"highlightedPage,omitempty"`
+	// The picture of the category, read from a config map or a secret key.
+	// The value is either a data URI or the raw bytes of the image.
+	// +kubebuilder:validation:Optional
+	Picture *refs.ValueFrom `json:"picture,omitempty"`
+}
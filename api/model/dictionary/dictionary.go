@@ -0,0 +1,108 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +kubebuilder:object:generate=true
+package dictionary
+
+import "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+
+type Kind string
+
+const (
+	Manual  Kind = "MANUAL"
+	Dynamic Kind = "DYNAMIC"
+)
+
+type Type struct {
+	// Dictionary ID. If empty, the dictionary is created and the sync fails if a dictionary with the same name exists.
+	// Setting the ID of an existing dictionary makes the resource manage it, including its deletion.
+	ID string `json:"id,omitempty"`
+	// Dictionary name. EL expressions reference the dictionary
+	// by the key derived from it, using #dictionaries['key']['property'].
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Dictionary description
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+	// The type of the dictionary. The properties of a manual dictionary are defined
+	// in the resource, while a dynamic dictionary is periodically fetched from its provider.
+	// +kubebuilder:validation:Enum=MANUAL;DYNAMIC
+	// +kubebuilder:default:=MANUAL
+	Kind Kind `json:"type,omitempty"`
+	// The properties of a manual dictionary
+	// +kubebuilder:validation:Optional
+	Properties map[string]string `json:"properties,omitempty"`
+	// Config maps or secrets whose entries are added to the properties of a manual dictionary.
+	// Later sources override earlier ones, and properties defined inline take precedence.
+	// +kubebuilder:validation:Optional
+	PropertiesFrom []*PropertiesSource `json:"propertiesFrom,omitempty"`
+	// The provider of a dynamic dictionary
+	// +kubebuilder:validation:Optional
+	Provider *Provider `json:"provider,omitempty"`
+	// How often a dynamic dictionary is fetched from its provider
+	// +kubebuilder:validation:Optional
+	Trigger *Trigger `json:"trigger,omitempty"`
+}
+
+// PropertiesSource references a config map or a secret, all the entries of which are read as properties.
+type PropertiesSource struct {
+	// The config map must be in the namespace of the dictionary.
+	// +kubebuilder:validation:Optional
+	ConfigMap *refs.NamespacedName `json:"configMapRef,omitempty"`
+	// The secret must be in the namespace of the dictionary.
+	// +kubebuilder:validation:Optional
+	Secret *refs.NamespacedName `json:"secretRef,omitempty"`
+}
+
+// Provider defines the HTTP endpoint a dynamic dictionary is fetched from.
+type Provider struct {
+	// +kubebuilder:validation:Enum=HTTP
+	// +kubebuilder:default:=HTTP
+	Type string `json:"type,omitempty"`
+	// The URL called to fetch the dictionary
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+	// +kubebuilder:validation:Enum=GET;POST;PUT;PATCH;DELETE;OPTIONS;HEAD;TRACE;CONNECT
+	// +kubebuilder:default:=GET
+	Method string `json:"method,omitempty"`
+	// +kubebuilder:validation:Optional
+	Headers []Header `json:"headers,omitempty"`
+	// +kubebuilder:validation:Optional
+	Body string `json:"body,omitempty"`
+	// The JOLT specification transforming the response into a list of key and value objects
+	// +kubebuilder:validation:Required
+	Specification string `json:"specification"`
+	// +kubebuilder:validation:Optional
+	UseSystemProxy bool `json:"useSystemProxy,omitempty"`
+}
+
+type Header struct {
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Value string `json:"value,omitempty"`
+}
+
+type Trigger struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	Rate int64 `json:"rate"`
+	// +kubebuilder:validation:Enum=MILLISECONDS;SECONDS;MINUTES;HOURS;DAYS
+	// +kubebuilder:default:=SECONDS
+	Unit string `json:"unit,omitempty"`
+}
+
+func (t *Type) IsDynamic() bool {
+	return t.Kind == Dynamic
+}
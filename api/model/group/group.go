@@ -0,0 +1,48 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +kubebuilder:object:generate=true
+package group
+
+type Member struct {
+	// Member source
+	// +kubebuilder:validation:Required
+	// +kubebuilder:example:=gravitee
+	Source string `json:"source"`
+	// Member source ID
+	// +kubebuilder:validation:Required
+	// +kubebuilder:example:=user@email.com
+	SourceID string `json:"sourceId"`
+	// The roles of the member, by scope (API, APPLICATION or INTEGRATION).
+	// Scopes that are not defined use the default roles of the group.
+	// +kubebuilder:validation:Optional
+	Roles map[string]string `json:"roles,omitempty"`
+}
+
+type Type struct {
+	// Group ID
+	ID string `json:"id,omitempty"`
+	// Group name, used by APIs and applications to reference the group
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Notify members when they are added to the group
+	// +kubebuilder:validation:Optional
+	NotifyMembers bool `json:"notifyMembers"`
+	// The roles given to members by default, by scope (API, APPLICATION or INTEGRATION)
+	// +kubebuilder:validation:Optional
+	DefaultRoles map[string]string `json:"defaultRoles,omitempty"`
+	// Group members
+	// +kubebuilder:validation:Optional
+	Members []Member `json:"members,omitempty"`
+}
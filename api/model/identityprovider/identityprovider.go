@@ -0,0 +1,104 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +kubebuilder:object:generate=true
+package identityprovider
+
+import (
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/utils"
+)
+
+// +kubebuilder:validation:Enum=OIDC;GITHUB;GOOGLE;GRAVITEEIO_AM;
+type ProviderType string
+
+type Type struct {
+	// Identity provider name, used by APIM to derive the ID of the identity provider
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Identity provider description
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+	// The type of the identity provider. The type cannot be changed once the identity provider has been created.
+	// +kubebuilder:validation:Required
+	Kind ProviderType `json:"type"`
+	// If false, users cannot log in using this identity provider
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=true
+	Enabled bool `json:"enabled"`
+	// If true, the identity provider is activated on the organization
+	// and can be used to log in to the console and the portal
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=true
+	Activated bool `json:"activated"`
+	// The configuration of the identity provider, depending on its type
+	// (e.g. clientId, tokenEndpoint, authorizeEndpoint, scopes ...)
+	// +kubebuilder:validation:Optional
+	Configuration *utils.GenericStringMap `json:"configuration,omitempty"`
+	// Configuration entries read from config maps or secrets, keyed by configuration property
+	// (e.g. clientSecret). These entries take precedence over the ones of the inline configuration.
+	// +kubebuilder:validation:Optional
+	ConfigurationFrom map[string]*refs.ValueFrom `json:"configurationFrom,omitempty"`
+	// If true, users must have an email to log in
+	// +kubebuilder:validation:Optional
+	EmailRequired bool `json:"emailRequired,omitempty"`
+	// If true, group and role mappings are computed each time a user logs in,
+	// otherwise only on the first login.
+	// +kubebuilder:validation:Optional
+	SyncMappings bool `json:"syncMappings,omitempty"`
+	// Maps the attributes of the user profile returned by the identity provider to APIM user attributes
+	// +kubebuilder:validation:Optional
+	UserProfileMapping *UserProfileMapping `json:"userProfileMapping,omitempty"`
+	// Grants membership to groups of the environment when a condition on the user profile is met
+	// +kubebuilder:validation:Optional
+	GroupMappings []*GroupMapping `json:"groupMappings,omitempty"`
+	// Grants organization and environment roles when a condition on the user profile is met
+	// +kubebuilder:validation:Optional
+	RoleMappings []*RoleMapping `json:"roleMappings,omitempty"`
+}
+
+type UserProfileMapping struct {
+	// +kubebuilder:validation:Optional
+	ID string `json:"id,omitempty"`
+	// +kubebuilder:validation:Optional
+	FirstName string `json:"firstname,omitempty"`
+	// +kubebuilder:validation:Optional
+	LastName string `json:"lastname,omitempty"`
+	// +kubebuilder:validation:Optional
+	Email string `json:"email,omitempty"`
+	// +kubebuilder:validation:Optional
+	Picture string `json:"picture,omitempty"`
+}
+
+type GroupMapping struct {
+	// An expression language condition evaluated against the user profile,
+	// e.g. {#jsonPath(#profile, '$.groups').contains('devs')}
+	// +kubebuilder:validation:Required
+	Condition string `json:"condition"`
+	// The names of the groups the user is added to
+	// +kubebuilder:validation:MinItems=1
+	Groups []string `json:"groups"`
+}
+
+type RoleMapping struct {
+	// An expression language condition evaluated against the user profile
+	// +kubebuilder:validation:Required
+	Condition string `json:"condition"`
+	// The organization roles granted to the user
+	// +kubebuilder:validation:Optional
+	Organizations []string `json:"organizations,omitempty"`
+	// The environment roles granted to the user, keyed by environment ID
+	// +kubebuilder:validation:Optional
+	Environments map[string][]string `json:"environments,omitempty"`
+}
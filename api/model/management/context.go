@@ -16,8 +16,12 @@
 package management
 
 import (
+	"slices"
+
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 var _ core.Auth = &Auth{}
@@ -46,6 +50,40 @@ type Context struct {
 	// OrgID is extracted from the token,
 	// EnvID is defaulted when the token contains exactly one environment.
 	Cloud *Cloud `json:"cloud,omitempty"`
+	// Patches applied, in order, to the definition of every API synced with this context.
+	// This allows a single API definition to be promoted across contexts,
+	// e.g. by rewriting backend targets or adding tags for the environment.
+	// +kubebuilder:validation:Optional
+	ApiPatches []ApiPatch `json:"apiPatches,omitempty"`
+	// Namespaces allowed to reference this context, in addition to the namespace of the context.
+	// If neither allowedNamespaces nor namespaceSelector are set, any namespace can reference the context.
+	// +kubebuilder:validation:Optional
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+	// A selector matching the labels of the namespaces allowed to reference this context,
+	// in addition to the namespace of the context and to allowedNamespaces.
+	// +kubebuilder:validation:Optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// RestrictsNamespaces returns true if only some namespaces are allowed to reference the context.
+func (c *Context) RestrictsNamespaces() bool {
+	return len(c.AllowedNamespaces) > 0 || c.NamespaceSelector != nil
+}
+
+// AllowsNamespace returns true if a namespace holding the given labels can reference the context.
+// The namespace of the context itself is not checked here, as it is always allowed.
+func (c *Context) AllowsNamespace(namespace string, namespaceLabels map[string]string) (bool, error) {
+	if !c.RestrictsNamespaces() || slices.Contains(c.AllowedNamespaces, namespace) {
+		return true, nil
+	}
+	if c.NamespaceSelector == nil {
+		return false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(c.NamespaceSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(namespaceLabels)), nil
 }
 
 // GetAuth implements custom.Context.
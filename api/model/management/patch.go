@@ -0,0 +1,43 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+const (
+	MergePatchType = "merge"
+	JSONPatchType  = "json"
+)
+
+type ApiPatch struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=merge
+	// +kubebuilder:validation:Enum=merge;json
+	// The type of the patch, either a JSON merge patch (RFC 7386)
+	// or a JSON patch (RFC 6902) holding a list of operations.
+	Type string `json:"type,omitempty"`
+	// +kubebuilder:validation:Required
+	// The patch, in JSON or YAML. Paths are relative to the API definition,
+	// that is to the spec of the API resource, e.g. /endpointGroups/0/endpoints/0/configuration/target
+	Patch string `json:"patch"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=V2;V4
+	// The definition version of the APIs the patch applies to.
+	// If omitted, the patch applies to all APIs synced with the context.
+	DefinitionVersion string `json:"definitionVersion,omitempty"`
+}
+
+// AppliesTo returns true if the patch must be applied to APIs holding the given definition version.
+func (p *ApiPatch) AppliesTo(version string) bool {
+	return p.DefinitionVersion == "" || p.DefinitionVersion == version
+}
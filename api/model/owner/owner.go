@@ -0,0 +1,73 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +kubebuilder:object:generate=true
+package owner
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	TypeUser  = "USER"
+	TypeGroup = "GROUP"
+
+	defaultSource = "gravitee"
+)
+
+// PrimaryOwner references the APIM user or group accountable for an API or an application.
+type PrimaryOwner struct {
+	// The type of the primary owner
+	// +kubebuilder:validation:Enum=USER;GROUP
+	// +kubebuilder:default:=USER
+	Type string `json:"type,omitempty"`
+	// The identity source of the user (e.g. gravitee, memory, ldap).
+	// This field is ignored when the primary owner is a group.
+	// +kubebuilder:default:=gravitee
+	Source string `json:"source,omitempty"`
+	// The source ID of the user, or the name of the group if the primary owner is a group
+	// +kubebuilder:validation:Required
+	// +kubebuilder:example:=user@email.com
+	Reference string `json:"reference"`
+	// The role given to the former primary owner when the ownership is transferred
+	// +kubebuilder:default:=OWNER
+	FormerOwnerRole string `json:"formerOwnerRole,omitempty"`
+}
+
+func (o *PrimaryOwner) IsGroup() bool {
+	return o.GetType() == TypeGroup
+}
+
+// GetType returns the upper-cased type of the primary owner, as expected by APIM.
+func (o *PrimaryOwner) GetType() string {
+	if o.Type == "" {
+		return TypeUser
+	}
+	return strings.ToUpper(o.Type)
+}
+
+func (o *PrimaryOwner) GetSource() string {
+	if o.Source == "" {
+		return defaultSource
+	}
+	return o.Source
+}
+
+func (o *PrimaryOwner) String() string {
+	if o.IsGroup() {
+		return fmt.Sprintf("group %s", o.Reference)
+	}
+	return fmt.Sprintf("user %s/%s", o.GetSource(), o.Reference)
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +kubebuilder:object:generate=true
+package portalpage
+
+import (
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+)
+
+const LinkPageType = "LINK"
+
+type Type struct {
+	// +kubebuilder:validation:Required
+	// This is the display name of the page in APIM and on the portal.
+	// The sync fails if a page with the same name already exists under the same parent in APIM.
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=MARKDOWN;SWAGGER;ASYNCAPI;ASCIIDOC;FOLDER;LINK
+	// The type of the documentation page, folder or link.
+	Kind string `json:"type"`
+	// +kubebuilder:validation:Optional
+	// The content of the page, if any. The content of a link is the URL it points to.
+	Content string `json:"content,omitempty"`
+	// +kubebuilder:validation:Optional
+	// The content of the page, read from a config map or a secret key.
+	// When set, it takes precedence over the inline content and the page is synced again
+	// each time the config map or the secret changes.
+	ContentFrom *refs.ValueFrom `json:"contentFrom,omitempty"`
+	// +kubebuilder:validation:Optional
+	// The order used to display the page in APIM and on the portal.
+	Order uint64 `json:"order"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=false
+	// If true, the page will be accessible from the portal (default is false)
+	Published bool `json:"published"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=PUBLIC
+	// +kubebuilder:validation:Enum=PUBLIC;PRIVATE
+	// The visibility of the page.
+	Visibility string `json:"visibility,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=false
+	// If true, this page will be displayed as the homepage of the portal.
+	HomePage bool `json:"homepage"`
+	// +kubebuilder:validation:Optional
+	// If the page is private, defines a set of user groups with access
+	AccessControls []base.AccessControl `json:"accessControls,omitempty"`
+	// +kubebuilder:validation:Optional
+	// if true, the references defined in the accessControls list will be
+	// denied access instead of being granted
+	ExcludedAccessControl bool `json:"excludedAccessControls"`
+	// +kubebuilder:validation:Optional
+	// A reference to the PortalPage folder this page belongs to.
+	// The folder must be managed with the same management context.
+	// If the namespace is omitted, the namespace of the page is used.
+	ParentRef *refs.NamespacedName `json:"parentRef,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Source allow you to fetch pages from various external sources, overriding page content
+	// each time the source is fetched.
+	Source *base.PageSource `json:"source,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Custom page configuration (e.g. page rendering can be changed to use Redoc instead of Swagger ui,
+	// or a link can be opened in a new tab)
+	Configuration map[string]string `json:"configuration,omitempty"`
+}
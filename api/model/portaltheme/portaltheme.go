@@ -0,0 +1,91 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +kubebuilder:object:generate=true
+package portaltheme
+
+import "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+
+// +kubebuilder:validation:Enum=PUBLIC;PRIVATE;
+type LinkVisibility string
+
+type Type struct {
+	// The name of the theme. If empty, the name of the current theme is kept.
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty"`
+	// The colors of the portal. Colors that are not set are reset to their default value.
+	// +kubebuilder:validation:Optional
+	Colors *Colors `json:"colors,omitempty"`
+	// The font family used across the portal, e.g. "Roboto", sans-serif
+	// +kubebuilder:validation:Optional
+	FontFamily string `json:"fontFamily,omitempty"`
+	// Custom CSS applied on top of the theme
+	// +kubebuilder:validation:Optional
+	CustomCSS string `json:"customCss,omitempty"`
+	// Custom CSS read from a config map or a secret key, appended to the inline custom CSS
+	// +kubebuilder:validation:Optional
+	CustomCSSFrom *refs.ValueFrom `json:"customCssFrom,omitempty"`
+	// The logo of the portal, read from a config map or a secret key.
+	// The value is either a data URI or the raw bytes of the image.
+	// +kubebuilder:validation:Optional
+	Logo *refs.ValueFrom `json:"logo,omitempty"`
+	// The favicon of the portal, read from a config map or a secret key.
+	// The value is either a data URI or the raw bytes of the image.
+	// +kubebuilder:validation:Optional
+	Favicon *refs.ValueFrom `json:"favicon,omitempty"`
+	// Links displayed in the navigation bar of the portal.
+	// Links created by the resource are deleted once they are removed from this list.
+	// Footer links cannot be managed, as APIM only exposes the links of the navigation bar.
+	// +kubebuilder:validation:Optional
+	Navigation []*Link `json:"navigation,omitempty"`
+}
+
+type Colors struct {
+	// +kubebuilder:validation:Optional
+	Primary string `json:"primary,omitempty"`
+	// +kubebuilder:validation:Optional
+	Secondary string `json:"secondary,omitempty"`
+	// +kubebuilder:validation:Optional
+	Tertiary string `json:"tertiary,omitempty"`
+	// +kubebuilder:validation:Optional
+	Error string `json:"error,omitempty"`
+	// +kubebuilder:validation:Optional
+	PageBackground string `json:"pageBackground,omitempty"`
+	// +kubebuilder:validation:Optional
+	CardBackground string `json:"cardBackground,omitempty"`
+}
+
+type Link struct {
+	// The label of the link, unique across the navigation links of the resource
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// The URL the link points to
+	// +kubebuilder:validation:Required
+	Target string `json:"target"`
+	// PUBLIC links are displayed to anonymous users, PRIVATE links to logged in users only
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=PUBLIC
+	Visibility LinkVisibility `json:"visibility,omitempty"`
+}
+
+// Sources returns the config map or secret references of the resource.
+func (t *Type) Sources() []*refs.ValueFrom {
+	sources := make([]*refs.ValueFrom, 0)
+	for _, from := range []*refs.ValueFrom{t.CustomCSSFrom, t.Logo, t.Favicon} {
+		if from != nil {
+			sources = append(sources, from)
+		}
+	}
+	return sources
+}
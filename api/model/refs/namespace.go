@@ -25,16 +25,29 @@ var _ core.ObjectRef = &NamespacedName{}
 type NamespacedName struct {
 	Name      string `json:"name"`
 	Namespace string `json:"namespace,omitempty"`
+	// Kind is only used by management context references, where ClusterManagementContext
+	// references a cluster scoped context by its name. The namespace is ignored in that case.
+	// +kubebuilder:validation:Optional
+	Kind string `json:"kind,omitempty"`
 }
 
 // SetNamespace implements custom.ResourceRef.
+// References to cluster scoped objects are left without a namespace.
 func (n *NamespacedName) SetNamespace(ns string) {
+	if n.IsClusterScoped() {
+		return
+	}
 	n.Namespace = ns
 }
 
 // IsMissingNamespace implements custom.ResourceRef.
 func (n *NamespacedName) IsMissingNamespace() bool {
-	return !n.HasNameSpace()
+	return !n.IsClusterScoped() && !n.HasNameSpace()
+}
+
+// IsClusterScoped returns true if the reference targets a cluster scoped management context.
+func (n *NamespacedName) IsClusterScoped() bool {
+	return n.Kind == core.CRDClusterManagementContextKind
 }
 
 // GetName implements custom.ResourceRef.
@@ -44,12 +57,20 @@ func (n *NamespacedName) GetName() string {
 
 // GetNamespace implements custom.ResourceRef.
 func (n *NamespacedName) GetNamespace() string {
+	if n.IsClusterScoped() {
+		return ""
+	}
 	return n.Namespace
 }
 
+// GetKind implements custom.ResourceRef.
+func (n *NamespacedName) GetKind() string {
+	return n.Kind
+}
+
 // HasNameSpace implements custom.ResourceRef.
 func (n *NamespacedName) HasNameSpace() bool {
-	return n.Namespace != ""
+	return n.GetNamespace() != ""
 }
 
 func NewNamespacedName(namespace, name string) NamespacedName {
@@ -57,9 +78,9 @@ func NewNamespacedName(namespace, name string) NamespacedName {
 }
 
 func (n *NamespacedName) NamespacedName() types.NamespacedName {
-	return types.NamespacedName{Namespace: n.Namespace, Name: n.Name}
+	return types.NamespacedName{Namespace: n.GetNamespace(), Name: n.Name}
 }
 
 func (n *NamespacedName) String() string {
-	return n.Namespace + "/" + n.Name
+	return n.GetNamespace() + "/" + n.Name
 }
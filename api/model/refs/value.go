@@ -0,0 +1,37 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package refs
+
+import "fmt"
+
+// KeyRef references a key of a config map or a secret in the namespace of the referencing resource.
+type KeyRef struct {
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+}
+
+func (r *KeyRef) String() string {
+	return fmt.Sprintf("%s/%s", r.Name, r.Key)
+}
+
+// ValueFrom defines a value read from a key of either a config map or a secret.
+type ValueFrom struct {
+	// +kubebuilder:validation:Optional
+	ConfigMapKeyRef *KeyRef `json:"configMapKeyRef,omitempty"`
+	// +kubebuilder:validation:Optional
+	SecretKeyRef *KeyRef `json:"secretKeyRef,omitempty"`
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +kubebuilder:object:generate=true
+package role
+
+// +kubebuilder:validation:Enum=API;APPLICATION;ENVIRONMENT;ORGANIZATION;
+type Scope string
+
+const (
+	APIScope          = Scope("API")
+	ApplicationScope  = Scope("APPLICATION")
+	EnvironmentScope  = Scope("ENVIRONMENT")
+	OrganizationScope = Scope("ORGANIZATION")
+)
+
+// +kubebuilder:validation:Enum=C;R;U;D;
+type Permission string
+
+type Type struct {
+	// Role name. Members reference the role by its name.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Role description
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+	// The scope of the role. The name and scope of a role cannot be changed once the role has been created.
+	// +kubebuilder:validation:Required
+	Scope Scope `json:"scope"`
+	// If true, the role is granted by default to new members of the scope
+	// +kubebuilder:validation:Optional
+	Default bool `json:"default,omitempty"`
+	// The permissions granted by the role, e.g. DEFINITION: [R, U].
+	// Each key is an APIM permission of the scope, and each value a list of create, read, update and delete rights.
+	// +kubebuilder:validation:Optional
+	Permissions map[string][]Permission `json:"permissions,omitempty"`
+}
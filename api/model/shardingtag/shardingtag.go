@@ -0,0 +1,29 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +kubebuilder:object:generate=true
+package shardingtag
+
+type Type struct {
+	// Sharding tag name. APIs and plans reference the tag by the ID derived from it.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Sharding tag description
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+	// The names of the groups allowed to deploy APIs using this tag.
+	// If empty, the tag can be used by anyone.
+	// +kubebuilder:validation:Optional
+	RestrictedGroups []string `json:"restrictedGroups,omitempty"`
+}
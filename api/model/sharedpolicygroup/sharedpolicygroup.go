@@ -0,0 +1,46 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +kubebuilder:object:generate=true
+package sharedpolicygroup
+
+import (
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+)
+
+type Type struct {
+	// The cross ID identifies the shared policy group across environments.
+	// If empty, it is generated from the resource UID.
+	// +kubebuilder:validation:Optional
+	CrossID string `json:"crossId,omitempty"`
+	// Shared policy group name
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Shared policy group description
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+	// A message displayed to API publishers about what the group expects from the APIs using it,
+	// e.g. a resource that must be defined
+	// +kubebuilder:validation:Optional
+	PrerequisiteMessage string `json:"prerequisiteMessage,omitempty"`
+	// The type of the APIs the group can be used by
+	// +kubebuilder:validation:Required
+	ApiType v4.ApiType `json:"apiType"`
+	// The flow phase the group can be used in
+	// +kubebuilder:validation:Required
+	Phase v4.FlowPhase `json:"phase"`
+	// The policies executed by the group, in order
+	// +kubebuilder:validation:Optional
+	Steps []*v4.FlowStep `json:"steps,omitempty"`
+}
@@ -0,0 +1,25 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +kubebuilder:object:generate=true
+package tenant
+
+type Type struct {
+	// Tenant name. Endpoints reference the tenant by the ID derived from it.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Tenant description
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+}
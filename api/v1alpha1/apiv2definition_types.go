@@ -24,6 +24,7 @@ import (
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
 
 	v2 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v2"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/owner"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/uuid"
@@ -51,6 +52,11 @@ type ApiDefinitionV2Spec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default:=true
 	IsLocal bool `json:"local"`
+	// The APIM user or group accountable for this resource.
+	// If omitted, the owner of the management context credentials is the primary owner.
+	// Changing this value transfers the ownership in APIM.
+	// +kubebuilder:validation:Optional
+	PrimaryOwner *owner.PrimaryOwner `json:"primaryOwner,omitempty"`
 }
 
 // ApiDefinitionStatus defines the observed state of API Definition.
@@ -24,6 +24,7 @@ import (
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
 
 	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/owner"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/uuid"
@@ -38,11 +39,26 @@ const separator = "/"
 type ApiV4DefinitionSpec struct {
 	v4.Api  `json:",inline"`
 	Context *refs.NamespacedName `json:"contextRef,omitempty"`
+	// The management contexts the API is deployed to, each of them possibly
+	// overriding endpoint groups, properties, plans and state of the API.
+	// This cannot be used together with contextRef.
+	// +kubebuilder:validation:Optional
+	Contexts []v4.ContextTarget `json:"contextRefs,omitempty"`
+	// The APIM user or group accountable for this resource.
+	// If omitted, the owner of the management context credentials is the primary owner.
+	// Changing this value transfers the ownership in APIM.
+	// +kubebuilder:validation:Optional
+	PrimaryOwner *owner.PrimaryOwner `json:"primaryOwner,omitempty"`
 }
 
 // ApiV4DefinitionStatus defines the observed state of API Definition.
 type ApiV4DefinitionStatus struct {
 	base.Status `json:",inline"`
+	// The state of the API in each of the management contexts listed in contextRefs.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=contextRef
+	Contexts []v4.ContextStatus `json:"contexts,omitempty"`
 }
 
 var _ core.ApiDefinitionObject = &ApiV4Definition{}
@@ -91,7 +107,41 @@ func (api *ApiV4Definition) PopulateIDs(context core.ContextModel) {
 	api.Spec.ID = api.pickID(context)
 	api.Spec.CrossID = api.pickCrossID()
 	api.Spec.Pages = api.pickPageIDs()
-	api.Spec.Plans = api.pickPlanIDs()
+	api.Spec.Plans = api.pickPlanIDs(context)
+}
+
+// HasContexts returns true if the API is deployed to the list of contexts defined in contextRefs.
+func (api *ApiV4Definition) HasContexts() bool {
+	return len(api.Spec.Contexts) > 0
+}
+
+// ForContext returns a copy of the API as it must be deployed to the given context,
+// holding the overrides of the context and the IDs already known for this context.
+func (api *ApiV4Definition) ForContext(target *v4.ContextTarget) *ApiV4Definition {
+	cp := api.DeepCopy()
+	cp.Spec.Context = &refs.NamespacedName{Name: target.Name, Namespace: target.Namespace, Kind: target.Kind}
+	if cp.Spec.Context.IsMissingNamespace() {
+		cp.Spec.Context.SetNamespace(api.Namespace)
+	}
+	target.ApplyTo(&cp.Spec.Api)
+
+	cp.Status.Status = base.Status{}
+	if status := api.GetContextStatus(cp.Spec.Context.String()); status != nil {
+		cp.Status.ID = status.ID
+		cp.Status.CrossID = status.CrossID
+		cp.Status.Plans = status.Plans
+	}
+	return cp
+}
+
+// GetContextStatus returns the status of the API in the given context, formatted as namespace/name.
+func (api *ApiV4Definition) GetContextStatus(context string) *v4.ContextStatus {
+	for i := range api.Status.Contexts {
+		if api.Status.Contexts[i].Context == context {
+			return &api.Status.Contexts[i]
+		}
+	}
+	return nil
 }
 
 // pickID returns the ID of the API definition, when a context has been defined at the spec level.
@@ -128,14 +178,19 @@ func (api *ApiV4Definition) pickCrossID() string {
 	return uuid.FromStrings(namespacedName.String())
 }
 
-func (api *ApiV4Definition) pickPlanIDs() map[string]*v4.Plan {
+// pickPlanIDs returns the plans of the API holding their IDs.
+// When the API is deployed to multiple contexts, generated IDs are derived from
+// the org and env of the context, as these contexts might target the same APIM instance.
+func (api *ApiV4Definition) pickPlanIDs(mCtx core.ContextModel) map[string]*v4.Plan {
 	plans := make(map[string]*v4.Plan, len(api.Spec.Plans))
 	for key, plan := range api.Spec.Plans {
 		p := plan.DeepCopy()
+		namespacedName := api.GetNamespacedName()
 		if id, ok := api.Status.Plans[key]; ok {
 			p.ID = id
+		} else if plan.ID == "" && api.HasContexts() && mCtx != nil {
+			p.ID = uuid.FromStrings(namespacedName.String(), key, mCtx.GetOrgID(), mCtx.GetEnvID())
 		} else if plan.ID == "" {
-			namespacedName := api.GetNamespacedName()
 			p.ID = uuid.FromStrings(namespacedName.String(), key)
 		}
 		plans[key] = p
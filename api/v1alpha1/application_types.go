@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/application"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/owner"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
@@ -36,6 +37,11 @@ type ApplicationSpec struct {
 	application.Application `json:",inline"`
 	// +kubebuilder:validation:Required
 	Context *refs.NamespacedName `json:"contextRef"`
+	// The APIM user or group accountable for this resource.
+	// If omitted, the owner of the management context credentials is the primary owner.
+	// Changing this value transfers the ownership in APIM.
+	// +kubebuilder:validation:Optional
+	PrimaryOwner *owner.PrimaryOwner `json:"primaryOwner,omitempty"`
 }
 
 // ApplicationStatus defines the observed state of Application.
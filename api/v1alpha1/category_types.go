@@ -0,0 +1,148 @@
+/*
+Copyright 2022 DAVID BRASSELY.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/category"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ core.ContextAwareObject = &Category{}
+var _ core.Spec = &CategorySpec{}
+
+// CategorySpec defines a category of APIs synced with a Gravitee API Management environment
+// +kubebuilder:object:generate=true
+type CategorySpec struct {
+	category.Type `json:",inline"`
+	// +kubebuilder:validation:Required
+	Context *refs.NamespacedName `json:"contextRef"`
+}
+
+// CategoryStatus defines the observed state of Category.
+type CategoryStatus struct {
+	category.Status `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Key",type=string,JSONPath=`.status.key`
+// +kubebuilder:printcolumn:name="Order",type=integer,JSONPath=`.spec.order`
+// +kubebuilder:resource:shortName=graviteecategories
+type Category struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CategorySpec   `json:"spec,omitempty"`
+	Status CategoryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type CategoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Category `json:"items"`
+}
+
+func (category *Category) IsBeingDeleted() bool {
+	return !category.ObjectMeta.DeletionTimestamp.IsZero()
+}
+
+func init() {
+	SchemeBuilder.Register(&Category{}, &CategoryList{})
+}
+
+// GetSpec implements custom.Resource.
+func (category *Category) GetSpec() core.Spec {
+	return &category.Spec
+}
+
+// GetStatus implements custom.Resource.
+func (category *Category) GetStatus() core.Status {
+	return &category.Status
+}
+
+func (category *Category) ContextRef() core.ObjectRef {
+	return category.Spec.Context
+}
+
+func (category *Category) HasContext() bool {
+	return category.Spec.Context != nil
+}
+
+// PopulateIDs sets the ID of the category from its status once it has been synced.
+// Until then, the ID is left empty so that the category gets created.
+func (category *Category) PopulateIDs(_ core.ContextModel) {
+	if category.Status.ID != "" {
+		category.Spec.ID = category.Status.ID
+	}
+}
+
+func (category *Category) GetID() string {
+	return category.Status.ID
+}
+
+func (category *Category) GetOrgID() string {
+	return category.Status.OrgID
+}
+
+func (category *Category) GetEnvID() string {
+	return category.Status.EnvID
+}
+
+func (category *Category) GetRef() core.ObjectRef {
+	return &refs.NamespacedName{
+		Name:      category.Name,
+		Namespace: category.Namespace,
+	}
+}
+
+func (spec *CategorySpec) Hash() string {
+	return hash.Calculate(spec)
+}
+
+func (s *CategoryStatus) DeepCopyFrom(obj client.Object) error {
+	switch t := obj.(type) {
+	case *Category:
+		t.Status.DeepCopyInto(s)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *CategoryStatus) DeepCopyTo(obj client.Object) error {
+	switch t := obj.(type) {
+	case *Category:
+		s.DeepCopyInto(&t.Status)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *CategoryStatus) SetProcessingStatus(status core.ProcessingStatus) {
+	s.Status.ProcessingStatus = status
+}
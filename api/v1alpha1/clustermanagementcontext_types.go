@@ -0,0 +1,178 @@
+/*
+ * Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ core.ContextObject = &ClusterManagementContext{}
+var _ core.ContextModel = &ClusterManagementContext{}
+var _ core.Spec = &ClusterManagementContextSpec{}
+var _ core.Status = &ClusterManagementContextStatus{}
+
+// ClusterManagementContext is a management context shared by all the namespaces of the cluster.
+// Secrets referenced by the context are resolved in the namespace of the operator.
+// +kubebuilder:object:generate=true
+type ClusterManagementContextSpec struct {
+	*management.Context `json:",inline"`
+}
+
+// Hash implements custom.Spec.
+func (spec *ClusterManagementContextSpec) Hash() string {
+	return hash.Calculate(spec)
+}
+
+// ClusterManagementContextStatus defines the observed state of a cluster management context.
+type ClusterManagementContextStatus struct {
+}
+
+// DeepCopyFrom implements custom.Status.
+func (st *ClusterManagementContextStatus) DeepCopyFrom(obj client.Object) error {
+	switch t := obj.(type) {
+	case *ClusterManagementContext:
+		t.Status.DeepCopyInto(st)
+		return nil
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+}
+
+// DeepCopyTo implements custom.Status.
+func (st *ClusterManagementContextStatus) DeepCopyTo(obj client.Object) error {
+	switch t := obj.(type) {
+	case *ClusterManagementContext:
+		st.DeepCopyInto(&t.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+}
+
+// SetProcessingStatus implements custom.Status.
+func (st *ClusterManagementContextStatus) SetProcessingStatus(status core.ProcessingStatus) {
+	// Not implemented
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=graviteeclustercontexts
+// +kubebuilder:printcolumn:name="BaseUrl",type=string,JSONPath=`.spec.baseUrl`
+type ClusterManagementContext struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterManagementContextSpec   `json:"spec,omitempty"`
+	Status ClusterManagementContextStatus `json:"status,omitempty"`
+}
+
+// DeepCopyResource implements custom.Context.
+func (ctx *ClusterManagementContext) DeepCopyResource() core.Object {
+	return ctx.DeepCopy()
+}
+
+// GetSpec implements custom.Context.
+func (ctx *ClusterManagementContext) GetSpec() core.Spec {
+	return &ctx.Spec
+}
+
+// GetStatus implements custom.Context.
+func (ctx *ClusterManagementContext) GetStatus() core.Status {
+	return &ctx.Status
+}
+
+// GetAuth implements custom.Context.
+func (ctx *ClusterManagementContext) GetAuth() core.Auth {
+	return ctx.Spec.Context.Auth
+}
+
+// GetEnvID implements custom.Context.
+func (ctx *ClusterManagementContext) GetEnvID() string {
+	return ctx.Spec.EnvID
+}
+
+// GetOrgID implements custom.Context.
+func (ctx *ClusterManagementContext) GetOrgID() string {
+	return ctx.Spec.OrgID
+}
+
+func (ctx *ClusterManagementContext) GetRef() core.ObjectRef {
+	return ctx.GetNamespacedName()
+}
+
+// GetSecretRef implements custom.Context.
+func (ctx *ClusterManagementContext) GetSecretRef() core.ObjectRef {
+	return ctx.Spec.SecretRef()
+}
+
+// GetURL implements custom.Context.
+func (ctx *ClusterManagementContext) GetURL() string {
+	return ctx.Spec.BaseUrl
+}
+
+// HasAuthentication implements custom.Context.
+func (ctx *ClusterManagementContext) HasAuthentication() bool {
+	return ctx.Spec.Auth != nil
+}
+
+// HasSecretRef implements custom.Context.
+func (ctx *ClusterManagementContext) HasSecretRef() bool {
+	return ctx.HasAuthentication() && ctx.Spec.Auth.SecretRef != nil
+}
+
+func (ctx *ClusterManagementContext) GetNamespacedName() *refs.NamespacedName {
+	return &refs.NamespacedName{Name: ctx.Name, Kind: core.CRDClusterManagementContextKind}
+}
+
+func (ctx *ClusterManagementContext) HasCloud() bool {
+	return ctx.Spec.HasCloud()
+}
+
+func (ctx *ClusterManagementContext) GetCloud() core.Cloud {
+	return ctx.Spec.Cloud
+}
+
+func (ctx *ClusterManagementContext) ConfigureCloud(url string, orgID string, envID string) {
+	ctx.Spec.ConfigureCloud(url, orgID, envID)
+}
+
+func (ctx *ClusterManagementContext) GetContext() core.ContextModel {
+	return ctx.Spec.Context
+}
+
+func (ctx *ClusterManagementContext) IsBeingDeleted() bool {
+	return !ctx.ObjectMeta.DeletionTimestamp.IsZero()
+}
+
+// +kubebuilder:object:root=true
+// ClusterManagementContextList contains a list of cluster management contexts.
+type ClusterManagementContextList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterManagementContext `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterManagementContext{}, &ClusterManagementContextList{})
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 DAVID BRASSELY.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/dictionary"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ core.ContextAwareObject = &Dictionary{}
+var _ core.Spec = &DictionarySpec{}
+
+// DictionarySpec defines a dictionary of properties synced with a Gravitee API Management environment
+// +kubebuilder:object:generate=true
+type DictionarySpec struct {
+	dictionary.Type `json:",inline"`
+	// +kubebuilder:validation:Required
+	Context *refs.NamespacedName `json:"contextRef"`
+}
+
+// DictionaryStatus defines the observed state of Dictionary.
+type DictionaryStatus struct {
+	dictionary.Status `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Key",type=string,JSONPath=`.status.key`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:resource:shortName=graviteedictionaries
+type Dictionary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DictionarySpec   `json:"spec,omitempty"`
+	Status DictionaryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type DictionaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dictionary `json:"items"`
+}
+
+func (dictionary *Dictionary) IsBeingDeleted() bool {
+	return !dictionary.ObjectMeta.DeletionTimestamp.IsZero()
+}
+
+func init() {
+	SchemeBuilder.Register(&Dictionary{}, &DictionaryList{})
+}
+
+// GetSpec implements custom.Resource.
+func (dictionary *Dictionary) GetSpec() core.Spec {
+	return &dictionary.Spec
+}
+
+// GetStatus implements custom.Resource.
+func (dictionary *Dictionary) GetStatus() core.Status {
+	return &dictionary.Status
+}
+
+func (dictionary *Dictionary) ContextRef() core.ObjectRef {
+	return dictionary.Spec.Context
+}
+
+func (dictionary *Dictionary) HasContext() bool {
+	return dictionary.Spec.Context != nil
+}
+
+// PopulateIDs sets the ID of the dictionary from its status once it has been synced.
+// Until then, the ID is left empty so that the dictionary gets created.
+func (dictionary *Dictionary) PopulateIDs(_ core.ContextModel) {
+	if dictionary.Status.ID != "" {
+		dictionary.Spec.ID = dictionary.Status.ID
+	}
+}
+
+func (dictionary *Dictionary) GetID() string {
+	return dictionary.Status.ID
+}
+
+func (dictionary *Dictionary) GetOrgID() string {
+	return dictionary.Status.OrgID
+}
+
+func (dictionary *Dictionary) GetEnvID() string {
+	return dictionary.Status.EnvID
+}
+
+func (dictionary *Dictionary) GetRef() core.ObjectRef {
+	return &refs.NamespacedName{
+		Name:      dictionary.Name,
+		Namespace: dictionary.Namespace,
+	}
+}
+
+func (spec *DictionarySpec) Hash() string {
+	return hash.Calculate(spec)
+}
+
+func (s *DictionaryStatus) DeepCopyFrom(obj client.Object) error {
+	switch t := obj.(type) {
+	case *Dictionary:
+		t.Status.DeepCopyInto(s)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *DictionaryStatus) DeepCopyTo(obj client.Object) error {
+	switch t := obj.(type) {
+	case *Dictionary:
+		s.DeepCopyInto(&t.Status)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *DictionaryStatus) SetProcessingStatus(status core.ProcessingStatus) {
+	s.Status.ProcessingStatus = status
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2022 DAVID BRASSELY.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/group"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ core.ContextAwareObject = &Group{}
+var _ core.Spec = &GroupSpec{}
+
+// GroupSpec defines a group of users synced with a Gravitee API Management environment
+// +kubebuilder:object:generate=true
+type GroupSpec struct {
+	group.Type `json:",inline"`
+	// +kubebuilder:validation:Required
+	Context *refs.NamespacedName `json:"contextRef"`
+}
+
+// GroupStatus defines the observed state of Group.
+type GroupStatus struct {
+	group.Status `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Members",type=integer,JSONPath=`.status.members`
+// +kubebuilder:resource:shortName=graviteegroups
+type Group struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GroupSpec   `json:"spec,omitempty"`
+	Status GroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type GroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Group `json:"items"`
+}
+
+func (group *Group) IsBeingDeleted() bool {
+	return !group.ObjectMeta.DeletionTimestamp.IsZero()
+}
+
+func init() {
+	SchemeBuilder.Register(&Group{}, &GroupList{})
+}
+
+// GetSpec implements custom.Resource.
+func (group *Group) GetSpec() core.Spec {
+	return &group.Spec
+}
+
+// GetStatus implements custom.Resource.
+func (group *Group) GetStatus() core.Status {
+	return &group.Status
+}
+
+func (group *Group) ContextRef() core.ObjectRef {
+	return group.Spec.Context
+}
+
+func (group *Group) HasContext() bool {
+	return group.Spec.Context != nil
+}
+
+func (group *Group) PopulateIDs(mCtx core.ContextModel) {
+	group.Spec.ID = group.pickID(mCtx)
+}
+
+// Generated IDs are derived from the namespaced name of the group and from the org and env
+// of its context, so that recreating the resource updates the same APIM group.
+func (group *Group) pickID(mCtx core.ContextModel) string {
+	if group.Status.ID != "" {
+		return group.Status.ID
+	}
+
+	if group.Spec.ID != "" {
+		return group.Spec.ID
+	}
+
+	if mCtx != nil {
+		crossID := uuid.FromStrings(group.GetRef().String())
+		return uuid.FromStrings(crossID, mCtx.GetOrgID(), mCtx.GetEnvID())
+	}
+
+	return string(group.UID)
+}
+
+func (group *Group) GetID() string {
+	return group.Status.ID
+}
+
+func (group *Group) GetOrgID() string {
+	return group.Status.OrgID
+}
+
+func (group *Group) GetEnvID() string {
+	return group.Status.EnvID
+}
+
+func (group *Group) GetRef() core.ObjectRef {
+	return &refs.NamespacedName{
+		Name:      group.Name,
+		Namespace: group.Namespace,
+	}
+}
+
+func (spec *GroupSpec) Hash() string {
+	return hash.Calculate(spec)
+}
+
+func (s *GroupStatus) DeepCopyFrom(obj client.Object) error {
+	switch t := obj.(type) {
+	case *Group:
+		t.Status.DeepCopyInto(s)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *GroupStatus) DeepCopyTo(obj client.Object) error {
+	switch t := obj.(type) {
+	case *Group:
+		s.DeepCopyInto(&t.Status)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *GroupStatus) SetProcessingStatus(status core.ProcessingStatus) {
+	s.Status.ProcessingStatus = status
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2022 DAVID BRASSELY.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/identityprovider"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ core.ContextAwareObject = &IdentityProvider{}
+var _ core.Spec = &IdentityProviderSpec{}
+
+// IdentityProviderSpec defines an identity provider of a Gravitee API Management organization,
+// used by users to log in to the console and the portal
+// +kubebuilder:object:generate=true
+type IdentityProviderSpec struct {
+	identityprovider.Type `json:",inline"`
+	// +kubebuilder:validation:Required
+	Context *refs.NamespacedName `json:"contextRef"`
+}
+
+// IdentityProviderStatus defines the observed state of IdentityProvider.
+type IdentityProviderStatus struct {
+	identityprovider.Status `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="ID",type=string,JSONPath=`.status.id`
+// +kubebuilder:resource:shortName=graviteeidps
+type IdentityProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IdentityProviderSpec   `json:"spec,omitempty"`
+	Status IdentityProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type IdentityProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IdentityProvider `json:"items"`
+}
+
+func (idp *IdentityProvider) IsBeingDeleted() bool {
+	return !idp.ObjectMeta.DeletionTimestamp.IsZero()
+}
+
+func init() {
+	SchemeBuilder.Register(&IdentityProvider{}, &IdentityProviderList{})
+}
+
+// GetSpec implements custom.Resource.
+func (idp *IdentityProvider) GetSpec() core.Spec {
+	return &idp.Spec
+}
+
+// GetStatus implements custom.Resource.
+func (idp *IdentityProvider) GetStatus() core.Status {
+	return &idp.Status
+}
+
+func (idp *IdentityProvider) ContextRef() core.ObjectRef {
+	return idp.Spec.Context
+}
+
+func (idp *IdentityProvider) HasContext() bool {
+	return idp.Spec.Context != nil
+}
+
+// PopulateIDs is a no-op, the ID of the identity provider being derived from its name by APIM.
+func (idp *IdentityProvider) PopulateIDs(_ core.ContextModel) {}
+
+func (idp *IdentityProvider) GetID() string {
+	return idp.Status.ID
+}
+
+func (idp *IdentityProvider) GetOrgID() string {
+	return idp.Status.OrgID
+}
+
+// GetEnvID returns an empty string, identity providers being defined at the organization level.
+func (idp *IdentityProvider) GetEnvID() string {
+	return ""
+}
+
+func (idp *IdentityProvider) GetRef() core.ObjectRef {
+	return &refs.NamespacedName{
+		Name:      idp.Name,
+		Namespace: idp.Namespace,
+	}
+}
+
+func (spec *IdentityProviderSpec) Hash() string {
+	return hash.Calculate(spec)
+}
+
+func (s *IdentityProviderStatus) DeepCopyFrom(obj client.Object) error {
+	switch t := obj.(type) {
+	case *IdentityProvider:
+		t.Status.DeepCopyInto(s)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *IdentityProviderStatus) DeepCopyTo(obj client.Object) error {
+	switch t := obj.(type) {
+	case *IdentityProvider:
+		s.DeepCopyInto(&t.Status)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *IdentityProviderStatus) SetProcessingStatus(status core.ProcessingStatus) {
+	s.Status.ProcessingStatus = status
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GraviteeIngressClassParametersSpec defines the options applied to every ingress
+// of an ingress class referencing this resource in its spec.parameters field.
+// +kubebuilder:object:generate=true
+type GraviteeIngressClassParametersSpec struct {
+	// Reference to the API definition used as a template for ingresses
+	// of this class that do not define the gravitee.io/template annotation.
+	// If the namespace is omitted, the namespace of this resource is used.
+	// +kubebuilder:validation:Optional
+	Template *refs.NamespacedName `json:"templateRef,omitempty"`
+	// The response returned by the gateway when no ingress rule matches the request.
+	// +kubebuilder:validation:Optional
+	NotFoundTemplate *IngressResponseTemplate `json:"notFoundTemplate,omitempty"`
+	// Response templates added to the APIs generated for ingresses of this class,
+	// unless the API template already defines a template for the same key.
+	// +kubebuilder:validation:Optional
+	ResponseTemplates map[string]map[string]*base.ResponseTemplate `json:"responseTemplates,omitempty"`
+	// The management context used to sync the APIs generated for ingresses of this class.
+	// If the namespace is omitted, the namespace of this resource is used.
+	// +kubebuilder:validation:Optional
+	Context *refs.NamespacedName `json:"contextRef,omitempty"`
+	// Reference to the config map used as a PEM registry by the gateways serving this class.
+	// If omitted, the config maps labeled as pem registries for this class are used.
+	// +kubebuilder:validation:Optional
+	PemRegistry *refs.NamespacedName `json:"pemRegistryRef,omitempty"`
+	// When set, the operator builds a keystore holding the TLS secrets of all the ingresses
+	// of this class, with one entry per host, that gateways can load to serve them using SNI.
+	// +kubebuilder:validation:Optional
+	Keystore *IngressKeystore `json:"keystore,omitempty"`
+	// The IP address or host name of the gateway serving this class,
+	// reported in the load balancer status of the ingresses.
+	// +kubebuilder:validation:Optional
+	GatewayAddress string `json:"gatewayAddress,omitempty"`
+}
+
+// IngressResponseTemplate defines a response returned by the gateway, either inline
+// or read from the content and contentType keys of a config map.
+// +kubebuilder:object:generate=true
+type IngressResponseTemplate struct {
+	// +kubebuilder:validation:Optional
+	Content string `json:"content,omitempty"`
+	// +kubebuilder:validation:Optional
+	ContentType string `json:"contentType,omitempty"`
+	// If the namespace is omitted, the namespace of the ingress class parameters is used.
+	// +kubebuilder:validation:Optional
+	ConfigMap *refs.NamespacedName `json:"configMapRef,omitempty"`
+}
+
+// IngressKeystore defines the keystore secret managed by the operator for an ingress class.
+// +kubebuilder:object:generate=true
+type IngressKeystore struct {
+	// The keystore type. A pkcs12 keystore can only hold a single private key,
+	// use jks when the ingresses of the class reference several TLS secrets.
+	// +kubebuilder:validation:Enum=jks;pkcs12
+	// +kubebuilder:default:=jks
+	Type string `json:"type,omitempty"`
+	// The name of the secret holding the keystore, created in the namespace of this resource.
+	// +kubebuilder:validation:Required
+	SecretName string `json:"secretName"`
+	// The secret key holding the keystore.
+	// +kubebuilder:default:=keystore
+	Key string `json:"key,omitempty"`
+	// The keystore password, either as plain text or as a reference to a secret or config map key
+	// using the kubernetes://<namespace>/(secrets|configmaps)/<name>/<key> syntax.
+	// +kubebuilder:validation:Required
+	Password string `json:"password"`
+}
+
+// Hash returns the hash of the spec, used to detect changes.
+func (spec *GraviteeIngressClassParametersSpec) Hash() string {
+	return hash.Calculate(spec)
+}
+
+// GraviteeIngressClassParameters is the Schema for the graviteeingressclassparameters API.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=graviteeingressparams
+type GraviteeIngressClassParameters struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GraviteeIngressClassParametersSpec `json:"spec,omitempty"`
+}
+
+func (params *GraviteeIngressClassParameters) GetRef() *refs.NamespacedName {
+	return &refs.NamespacedName{
+		Namespace: params.Namespace,
+		Name:      params.Name,
+	}
+}
+
+// ResolveRef returns the given reference, defaulting
+// its namespace to the namespace of the parameters.
+func (params *GraviteeIngressClassParameters) ResolveRef(ref *refs.NamespacedName) *refs.NamespacedName {
+	if ref == nil {
+		return nil
+	}
+
+	resolved := ref.DeepCopy()
+	if resolved.IsMissingNamespace() {
+		resolved.SetNamespace(params.Namespace)
+	}
+
+	return resolved
+}
+
+// +kubebuilder:object:root=true
+
+// GraviteeIngressClassParametersList contains a list of GraviteeIngressClassParameters.
+type GraviteeIngressClassParametersList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GraviteeIngressClassParameters `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GraviteeIngressClassParameters{}, &GraviteeIngressClassParametersList{})
+}
@@ -36,6 +36,11 @@ var _ core.Status = &ManagementContextStatus{}
 // +kubebuilder:object:generate=true
 type ManagementContextSpec struct {
 	*management.Context `json:",inline"`
+	// If true, API definitions and applications of the namespace that do not reference
+	// any management context are defaulted to this context when they are created.
+	// A context referenced by the gravitee.io/default-context annotation of the namespace takes precedence.
+	// +kubebuilder:validation:Optional
+	Default bool `json:"default,omitempty"`
 }
 
 // Hash implements custom.Spec.
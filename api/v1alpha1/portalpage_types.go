@@ -0,0 +1,157 @@
+/*
+Copyright 2022 DAVID BRASSELY.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/portalpage"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ core.ContextAwareObject = &PortalPage{}
+var _ core.Spec = &PortalPageSpec{}
+
+// PortalPageSpec defines a documentation page of the portal synced with a Gravitee API Management environment
+// +kubebuilder:object:generate=true
+type PortalPageSpec struct {
+	portalpage.Type `json:",inline"`
+	// +kubebuilder:validation:Required
+	Context *refs.NamespacedName `json:"contextRef"`
+}
+
+// PortalPageStatus defines the observed state of PortalPage.
+type PortalPageStatus struct {
+	portalpage.Status `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Published",type=boolean,JSONPath=`.spec.published`
+// +kubebuilder:resource:shortName=graviteeportalpages
+type PortalPage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PortalPageSpec   `json:"spec,omitempty"`
+	Status PortalPageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type PortalPageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PortalPage `json:"items"`
+}
+
+func (page *PortalPage) IsBeingDeleted() bool {
+	return !page.ObjectMeta.DeletionTimestamp.IsZero()
+}
+
+func init() {
+	SchemeBuilder.Register(&PortalPage{}, &PortalPageList{})
+}
+
+// GetSpec implements custom.Resource.
+func (page *PortalPage) GetSpec() core.Spec {
+	return &page.Spec
+}
+
+// GetStatus implements custom.Resource.
+func (page *PortalPage) GetStatus() core.Status {
+	return &page.Status
+}
+
+func (page *PortalPage) ContextRef() core.ObjectRef {
+	return page.Spec.Context
+}
+
+func (page *PortalPage) HasContext() bool {
+	return page.Spec.Context != nil
+}
+
+// PopulateIDs is a no-op as the ID of the page is only known from its status once it has been synced.
+// Until then, the page gets created.
+func (page *PortalPage) PopulateIDs(_ core.ContextModel) {}
+
+func (page *PortalPage) GetID() string {
+	return page.Status.ID
+}
+
+func (page *PortalPage) GetOrgID() string {
+	return page.Status.OrgID
+}
+
+func (page *PortalPage) GetEnvID() string {
+	return page.Status.EnvID
+}
+
+func (page *PortalPage) GetRef() core.ObjectRef {
+	return &refs.NamespacedName{
+		Name:      page.Name,
+		Namespace: page.Namespace,
+	}
+}
+
+// GetParentRef returns the reference of the parent folder of the page, if any,
+// defaulting to the namespace of the page.
+func (page *PortalPage) GetParentRef() *refs.NamespacedName {
+	if page.Spec.ParentRef == nil {
+		return nil
+	}
+	parent := refs.NewNamespacedName(page.Spec.ParentRef.Namespace, page.Spec.ParentRef.Name)
+	if parent.Namespace == "" {
+		parent.Namespace = page.Namespace
+	}
+	return &parent
+}
+
+func (spec *PortalPageSpec) Hash() string {
+	return hash.Calculate(spec)
+}
+
+func (s *PortalPageStatus) DeepCopyFrom(obj client.Object) error {
+	switch t := obj.(type) {
+	case *PortalPage:
+		t.Status.DeepCopyInto(s)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *PortalPageStatus) DeepCopyTo(obj client.Object) error {
+	switch t := obj.(type) {
+	case *PortalPage:
+		s.DeepCopyInto(&t.Status)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *PortalPageStatus) SetProcessingStatus(status core.ProcessingStatus) {
+	s.Status.ProcessingStatus = status
+}
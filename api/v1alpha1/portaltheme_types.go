@@ -0,0 +1,143 @@
+/*
+Copyright 2022 DAVID BRASSELY.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/portaltheme"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ core.ContextAwareObject = &PortalTheme{}
+var _ core.Spec = &PortalThemeSpec{}
+
+// PortalThemeSpec defines the theme and the navigation links of the developer portal
+// of a Gravitee API Management environment
+// +kubebuilder:object:generate=true
+type PortalThemeSpec struct {
+	portaltheme.Type `json:",inline"`
+	// +kubebuilder:validation:Required
+	Context *refs.NamespacedName `json:"contextRef"`
+}
+
+// PortalThemeStatus defines the observed state of PortalTheme.
+type PortalThemeStatus struct {
+	portaltheme.Status `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="ID",type=string,JSONPath=`.status.id`
+// +kubebuilder:resource:shortName=graviteethemes
+type PortalTheme struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PortalThemeSpec   `json:"spec,omitempty"`
+	Status PortalThemeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type PortalThemeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PortalTheme `json:"items"`
+}
+
+func (theme *PortalTheme) IsBeingDeleted() bool {
+	return !theme.ObjectMeta.DeletionTimestamp.IsZero()
+}
+
+func init() {
+	SchemeBuilder.Register(&PortalTheme{}, &PortalThemeList{})
+}
+
+// GetSpec implements custom.Resource.
+func (theme *PortalTheme) GetSpec() core.Spec {
+	return &theme.Spec
+}
+
+// GetStatus implements custom.Resource.
+func (theme *PortalTheme) GetStatus() core.Status {
+	return &theme.Status
+}
+
+func (theme *PortalTheme) ContextRef() core.ObjectRef {
+	return theme.Spec.Context
+}
+
+func (theme *PortalTheme) HasContext() bool {
+	return theme.Spec.Context != nil
+}
+
+// PopulateIDs is a no-op, the theme being the current theme of the environment.
+func (theme *PortalTheme) PopulateIDs(_ core.ContextModel) {}
+
+func (theme *PortalTheme) GetID() string {
+	return theme.Status.ID
+}
+
+func (theme *PortalTheme) GetOrgID() string {
+	return theme.Status.OrgID
+}
+
+func (theme *PortalTheme) GetEnvID() string {
+	return theme.Status.EnvID
+}
+
+func (theme *PortalTheme) GetRef() core.ObjectRef {
+	return &refs.NamespacedName{
+		Name:      theme.Name,
+		Namespace: theme.Namespace,
+	}
+}
+
+func (spec *PortalThemeSpec) Hash() string {
+	return hash.Calculate(spec)
+}
+
+func (s *PortalThemeStatus) DeepCopyFrom(obj client.Object) error {
+	switch t := obj.(type) {
+	case *PortalTheme:
+		t.Status.DeepCopyInto(s)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *PortalThemeStatus) DeepCopyTo(obj client.Object) error {
+	switch t := obj.(type) {
+	case *PortalTheme:
+		s.DeepCopyInto(&t.Status)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *PortalThemeStatus) SetProcessingStatus(status core.ProcessingStatus) {
+	s.Status.ProcessingStatus = status
+}
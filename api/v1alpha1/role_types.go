@@ -0,0 +1,145 @@
+/*
+Copyright 2022 DAVID BRASSELY.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/role"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ core.ContextAwareObject = &Role{}
+var _ core.Spec = &RoleSpec{}
+
+// RoleSpec defines a custom role of a Gravitee API Management organization,
+// that can be granted to members of APIs, applications, environments or of the organization itself
+// +kubebuilder:object:generate=true
+type RoleSpec struct {
+	role.Type `json:",inline"`
+	// +kubebuilder:validation:Required
+	Context *refs.NamespacedName `json:"contextRef"`
+}
+
+// RoleStatus defines the observed state of Role.
+type RoleStatus struct {
+	role.Status `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Scope",type=string,JSONPath=`.spec.scope`
+// +kubebuilder:printcolumn:name="ID",type=string,JSONPath=`.status.id`
+// +kubebuilder:resource:shortName=graviteeroles
+type Role struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RoleSpec   `json:"spec,omitempty"`
+	Status RoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type RoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Role `json:"items"`
+}
+
+func (role *Role) IsBeingDeleted() bool {
+	return !role.ObjectMeta.DeletionTimestamp.IsZero()
+}
+
+func init() {
+	SchemeBuilder.Register(&Role{}, &RoleList{})
+}
+
+// GetSpec implements custom.Resource.
+func (role *Role) GetSpec() core.Spec {
+	return &role.Spec
+}
+
+// GetStatus implements custom.Resource.
+func (role *Role) GetStatus() core.Status {
+	return &role.Status
+}
+
+func (role *Role) ContextRef() core.ObjectRef {
+	return role.Spec.Context
+}
+
+func (role *Role) HasContext() bool {
+	return role.Spec.Context != nil
+}
+
+// PopulateIDs is a no-op, the ID of the role being generated by APIM.
+func (role *Role) PopulateIDs(_ core.ContextModel) {}
+
+func (role *Role) GetID() string {
+	return role.Status.ID
+}
+
+func (role *Role) GetOrgID() string {
+	return role.Status.OrgID
+}
+
+// GetEnvID returns an empty string, roles being defined at the organization level.
+func (role *Role) GetEnvID() string {
+	return ""
+}
+
+func (role *Role) GetRef() core.ObjectRef {
+	return &refs.NamespacedName{
+		Name:      role.Name,
+		Namespace: role.Namespace,
+	}
+}
+
+func (spec *RoleSpec) Hash() string {
+	return hash.Calculate(spec)
+}
+
+func (s *RoleStatus) DeepCopyFrom(obj client.Object) error {
+	switch t := obj.(type) {
+	case *Role:
+		t.Status.DeepCopyInto(s)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *RoleStatus) DeepCopyTo(obj client.Object) error {
+	switch t := obj.(type) {
+	case *Role:
+		s.DeepCopyInto(&t.Status)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *RoleStatus) SetProcessingStatus(status core.ProcessingStatus) {
+	s.Status.ProcessingStatus = status
+}
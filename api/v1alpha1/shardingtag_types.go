@@ -0,0 +1,144 @@
+/*
+Copyright 2022 DAVID BRASSELY.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/shardingtag"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ core.ContextAwareObject = &ShardingTag{}
+var _ core.Spec = &ShardingTagSpec{}
+
+// ShardingTagSpec defines a sharding tag of a Gravitee API Management organization,
+// used to deploy APIs on a subset of the gateways
+// +kubebuilder:object:generate=true
+type ShardingTagSpec struct {
+	shardingtag.Type `json:",inline"`
+	// +kubebuilder:validation:Required
+	Context *refs.NamespacedName `json:"contextRef"`
+}
+
+// ShardingTagStatus defines the observed state of ShardingTag.
+type ShardingTagStatus struct {
+	shardingtag.Status `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="ID",type=string,JSONPath=`.status.id`
+// +kubebuilder:resource:shortName=graviteeshardingtags
+type ShardingTag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ShardingTagSpec   `json:"spec,omitempty"`
+	Status ShardingTagStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type ShardingTagList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ShardingTag `json:"items"`
+}
+
+func (tag *ShardingTag) IsBeingDeleted() bool {
+	return !tag.ObjectMeta.DeletionTimestamp.IsZero()
+}
+
+func init() {
+	SchemeBuilder.Register(&ShardingTag{}, &ShardingTagList{})
+}
+
+// GetSpec implements custom.Resource.
+func (tag *ShardingTag) GetSpec() core.Spec {
+	return &tag.Spec
+}
+
+// GetStatus implements custom.Resource.
+func (tag *ShardingTag) GetStatus() core.Status {
+	return &tag.Status
+}
+
+func (tag *ShardingTag) ContextRef() core.ObjectRef {
+	return tag.Spec.Context
+}
+
+func (tag *ShardingTag) HasContext() bool {
+	return tag.Spec.Context != nil
+}
+
+// PopulateIDs is a no-op, the ID of the sharding tag being derived from its name by APIM.
+func (tag *ShardingTag) PopulateIDs(_ core.ContextModel) {}
+
+func (tag *ShardingTag) GetID() string {
+	return tag.Status.ID
+}
+
+func (tag *ShardingTag) GetOrgID() string {
+	return tag.Status.OrgID
+}
+
+// GetEnvID returns an empty string, sharding tags being defined at the organization level.
+func (tag *ShardingTag) GetEnvID() string {
+	return ""
+}
+
+func (tag *ShardingTag) GetRef() core.ObjectRef {
+	return &refs.NamespacedName{
+		Name:      tag.Name,
+		Namespace: tag.Namespace,
+	}
+}
+
+func (spec *ShardingTagSpec) Hash() string {
+	return hash.Calculate(spec)
+}
+
+func (s *ShardingTagStatus) DeepCopyFrom(obj client.Object) error {
+	switch t := obj.(type) {
+	case *ShardingTag:
+		t.Status.DeepCopyInto(s)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *ShardingTagStatus) DeepCopyTo(obj client.Object) error {
+	switch t := obj.(type) {
+	case *ShardingTag:
+		s.DeepCopyInto(&t.Status)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *ShardingTagStatus) SetProcessingStatus(status core.ProcessingStatus) {
+	s.Status.ProcessingStatus = status
+}
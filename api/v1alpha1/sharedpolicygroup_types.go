@@ -0,0 +1,154 @@
+/*
+Copyright 2022 DAVID BRASSELY.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/sharedpolicygroup"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ core.ContextAwareObject = &SharedPolicyGroup{}
+var _ core.Spec = &SharedPolicyGroupSpec{}
+
+// SharedPolicyGroupSpec defines a group of policies that V4 APIs can reuse in their flows,
+// synced with a Gravitee API Management environment
+// +kubebuilder:object:generate=true
+type SharedPolicyGroupSpec struct {
+	sharedpolicygroup.Type `json:",inline"`
+	// +kubebuilder:validation:Required
+	Context *refs.NamespacedName `json:"contextRef"`
+}
+
+// SharedPolicyGroupStatus defines the observed state of SharedPolicyGroup.
+type SharedPolicyGroupStatus struct {
+	sharedpolicygroup.Status `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Api Type",type=string,JSONPath=`.spec.apiType`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.spec.phase`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:resource:shortName=graviteesharedpolicygroups
+type SharedPolicyGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SharedPolicyGroupSpec   `json:"spec,omitempty"`
+	Status SharedPolicyGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type SharedPolicyGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SharedPolicyGroup `json:"items"`
+}
+
+func (group *SharedPolicyGroup) IsBeingDeleted() bool {
+	return !group.ObjectMeta.DeletionTimestamp.IsZero()
+}
+
+func init() {
+	SchemeBuilder.Register(&SharedPolicyGroup{}, &SharedPolicyGroupList{})
+}
+
+// GetSpec implements custom.Resource.
+func (group *SharedPolicyGroup) GetSpec() core.Spec {
+	return &group.Spec
+}
+
+// GetStatus implements custom.Resource.
+func (group *SharedPolicyGroup) GetStatus() core.Status {
+	return &group.Status
+}
+
+func (group *SharedPolicyGroup) ContextRef() core.ObjectRef {
+	return group.Spec.Context
+}
+
+func (group *SharedPolicyGroup) HasContext() bool {
+	return group.Spec.Context != nil
+}
+
+// PopulateIDs sets the cross ID of the shared policy group from its status once it has been synced.
+// Until then, the cross ID is either given in the spec or generated from the resource UID.
+func (group *SharedPolicyGroup) PopulateIDs(_ core.ContextModel) {
+	if group.Status.CrossID != "" {
+		group.Spec.CrossID = group.Status.CrossID
+	}
+
+	if group.Spec.CrossID == "" {
+		group.Spec.CrossID = string(group.UID)
+	}
+}
+
+func (group *SharedPolicyGroup) GetID() string {
+	return group.Status.ID
+}
+
+func (group *SharedPolicyGroup) GetOrgID() string {
+	return group.Status.OrgID
+}
+
+func (group *SharedPolicyGroup) GetEnvID() string {
+	return group.Status.EnvID
+}
+
+func (group *SharedPolicyGroup) GetRef() core.ObjectRef {
+	return &refs.NamespacedName{
+		Name:      group.Name,
+		Namespace: group.Namespace,
+	}
+}
+
+func (spec *SharedPolicyGroupSpec) Hash() string {
+	return hash.Calculate(spec)
+}
+
+func (s *SharedPolicyGroupStatus) DeepCopyFrom(obj client.Object) error {
+	switch t := obj.(type) {
+	case *SharedPolicyGroup:
+		t.Status.DeepCopyInto(s)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *SharedPolicyGroupStatus) DeepCopyTo(obj client.Object) error {
+	switch t := obj.(type) {
+	case *SharedPolicyGroup:
+		s.DeepCopyInto(&t.Status)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *SharedPolicyGroupStatus) SetProcessingStatus(status core.ProcessingStatus) {
+	s.Status.ProcessingStatus = status
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2022 DAVID BRASSELY.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/tenant"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ core.ContextAwareObject = &Tenant{}
+var _ core.Spec = &TenantSpec{}
+
+// TenantSpec defines a tenant of a Gravitee API Management organization, used to route requests to endpoints
+// +kubebuilder:object:generate=true
+type TenantSpec struct {
+	tenant.Type `json:",inline"`
+	// +kubebuilder:validation:Required
+	Context *refs.NamespacedName `json:"contextRef"`
+}
+
+// TenantStatus defines the observed state of Tenant.
+type TenantStatus struct {
+	tenant.Status `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="ID",type=string,JSONPath=`.status.id`
+// +kubebuilder:resource:shortName=graviteetenants
+type Tenant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantSpec   `json:"spec,omitempty"`
+	Status TenantStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type TenantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Tenant `json:"items"`
+}
+
+func (tenant *Tenant) IsBeingDeleted() bool {
+	return !tenant.ObjectMeta.DeletionTimestamp.IsZero()
+}
+
+func init() {
+	SchemeBuilder.Register(&Tenant{}, &TenantList{})
+}
+
+// GetSpec implements custom.Resource.
+func (tenant *Tenant) GetSpec() core.Spec {
+	return &tenant.Spec
+}
+
+// GetStatus implements custom.Resource.
+func (tenant *Tenant) GetStatus() core.Status {
+	return &tenant.Status
+}
+
+func (tenant *Tenant) ContextRef() core.ObjectRef {
+	return tenant.Spec.Context
+}
+
+func (tenant *Tenant) HasContext() bool {
+	return tenant.Spec.Context != nil
+}
+
+// PopulateIDs is a no-op, the ID of the tenant being derived from its name by APIM.
+func (tenant *Tenant) PopulateIDs(_ core.ContextModel) {}
+
+func (tenant *Tenant) GetID() string {
+	return tenant.Status.ID
+}
+
+func (tenant *Tenant) GetOrgID() string {
+	return tenant.Status.OrgID
+}
+
+// GetEnvID returns an empty string, tenants being defined at the organization level.
+func (tenant *Tenant) GetEnvID() string {
+	return ""
+}
+
+func (tenant *Tenant) GetRef() core.ObjectRef {
+	return &refs.NamespacedName{
+		Name:      tenant.Name,
+		Namespace: tenant.Namespace,
+	}
+}
+
+func (spec *TenantSpec) Hash() string {
+	return hash.Calculate(spec)
+}
+
+func (s *TenantStatus) DeepCopyFrom(obj client.Object) error {
+	switch t := obj.(type) {
+	case *Tenant:
+		t.Status.DeepCopyInto(s)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *TenantStatus) DeepCopyTo(obj client.Object) error {
+	switch t := obj.(type) {
+	case *Tenant:
+		s.DeepCopyInto(&t.Status)
+	default:
+		return fmt.Errorf("unknown type %T", t)
+	}
+
+	return nil
+}
+
+func (s *TenantStatus) SetProcessingStatus(status core.ProcessingStatus) {
+	s.Status.ProcessingStatus = status
+}
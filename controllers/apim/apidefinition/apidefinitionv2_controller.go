@@ -26,6 +26,7 @@ import (
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/watch"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -58,10 +59,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 }
 
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.ApiDefinition{}).
 		Watches(&v1alpha1.ManagementContext{}, r.Watcher.WatchContexts(indexer.ApiContextField)).
 		Watches(&v1alpha1.ApiResource{}, r.Watcher.WatchResources(indexer.ApiResourceField)).
+		Watches(&v1alpha1.Group{}, r.Watcher.WatchGroups(indexer.ApiGroupField)).
+		Watches(&v1alpha1.Category{}, r.Watcher.WatchCategories(indexer.ApiCategoryField)).
+		Watches(&corev1.ConfigMap{}, r.Watcher.WatchConfigMaps(indexer.ApiPageConfigMapField)).
+		Watches(&corev1.Secret{}, r.Watcher.WatchSecrets(indexer.ApiPageSecretField))
+	return r.Watcher.WatchClusterContexts(b, indexer.ApiContextField).
 		WithEventFilter(predicate.LastSpecHashPredicate{}).
 		Complete(r)
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/predicate"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/watch"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -57,10 +58,16 @@ func (r *V4Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 }
 
 func (r *V4Reconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.ApiV4Definition{}).
 		Watches(&v1alpha1.ManagementContext{}, r.Watcher.WatchContexts(indexer.ApiV4ContextField)).
 		Watches(&v1alpha1.ApiResource{}, r.Watcher.WatchResources(indexer.ApiV4ResourceField)).
+		Watches(&v1alpha1.Group{}, r.Watcher.WatchGroups(indexer.ApiV4GroupField)).
+		Watches(&v1alpha1.Category{}, r.Watcher.WatchCategories(indexer.ApiV4CategoryField)).
+		Watches(&corev1.ConfigMap{}, r.Watcher.WatchConfigMaps(indexer.ApiV4PageConfigMapField)).
+		Watches(&corev1.Secret{}, r.Watcher.WatchSecrets(indexer.ApiV4PageSecretField)).
+		Watches(&v1alpha1.SharedPolicyGroup{}, r.Watcher.WatchSharedPolicyGroups(indexer.ApiV4SharedPolicyGroupField))
+	return r.Watcher.WatchClusterContexts(b, indexer.ApiV4ContextField).
 		WithEventFilter(predicate.LastSpecHashPredicate{}).
 		Complete(r)
 }
@@ -0,0 +1,100 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// checkCategories verifies that the categories referenced by the API exist in APIM and reports the
+// result as a condition, so that a missing category is not only surfaced as an import error.
+// A Category resource being created triggers a new reconcile of the APIs referencing it.
+func checkCategories(apim *apim.APIM, categories []string, status *base.Status, generation int64) error {
+	missing, err := apim.Categories.FindMissing(categories)
+	if err != nil {
+		return errors.NewContextError(err)
+	}
+
+	if len(missing) == 0 {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               base.ConditionCategoriesResolved,
+			Status:             metav1.ConditionTrue,
+			Reason:             base.ReasonCategoriesFound,
+			ObservedGeneration: generation,
+		})
+		return nil
+	}
+
+	message := fmt.Sprintf(
+		"categories [%s] do not exist in APIM, create them or define the corresponding Category resources",
+		strings.Join(missing, ", "),
+	)
+
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               base.ConditionCategoriesResolved,
+		Status:             metav1.ConditionFalse,
+		Reason:             base.ReasonCategoryNotFound,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+
+	return errors.NewUnrecoverableError(message)
+}
+
+type contextCondition struct {
+	context   string
+	condition *metav1.Condition
+}
+
+// setCategoriesCondition reports the categories of an API synced with several contexts as a single condition,
+// which is false as soon as categories are missing from one of the contexts.
+// The condition is left untouched if the categories have not been checked against any context.
+func setCategoriesCondition(status *base.Status, conditions []contextCondition, generation int64) {
+	if len(conditions) == 0 {
+		return
+	}
+
+	messages := make([]string, 0)
+	for _, c := range conditions {
+		if c.condition.Status == metav1.ConditionFalse {
+			messages = append(messages, fmt.Sprintf("context [%s]: %s", c.context, c.condition.Message))
+		}
+	}
+
+	if len(messages) == 0 {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               base.ConditionCategoriesResolved,
+			Status:             metav1.ConditionTrue,
+			Reason:             base.ReasonCategoriesFound,
+			ObservedGeneration: generation,
+		})
+		return
+	}
+
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               base.ConditionCategoriesResolved,
+		Status:             metav1.ConditionFalse,
+		Reason:             base.ReasonCategoryNotFound,
+		Message:            strings.Join(messages, "; "),
+		ObservedGeneration: generation,
+	})
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/overlay"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/sharedpolicygroups"
+	"k8s.io/apimachinery/pkg/api/meta"
+	kErrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// The API is synced with each of the contexts listed in contextRefs.
+// A context failing to sync does not prevent the API from being synced with other contexts,
+// and the API is deleted from the contexts that have been removed from the list.
+func createOrUpdateV4Contexts(ctx context.Context, apiDefinition, cp *v1alpha1.ApiV4Definition) error {
+	errs := make([]error, 0)
+	statuses := make([]v4.ContextStatus, 0, len(cp.Spec.Contexts))
+	categories := make([]contextCondition, 0, len(cp.Spec.Contexts))
+
+	for i := range cp.Spec.Contexts {
+		target := cp.ForContext(&cp.Spec.Contexts[i])
+		status := v4.ContextStatus{Context: target.Spec.Context.String()}
+		if previous := apiDefinition.GetContextStatus(status.Context); previous != nil {
+			previous.DeepCopyInto(&status)
+		}
+
+		err := syncContext(ctx, target, &status)
+		condition := meta.FindStatusCondition(target.Status.Conditions, base.ConditionCategoriesResolved)
+		if condition != nil {
+			categories = append(categories, contextCondition{context: status.Context, condition: condition})
+		}
+		if err != nil {
+			log.FromContext(ctx).Error(err, "Unable to sync API with APIM", "context", status.Context)
+			status.ProcessingStatus = core.ProcessingStatusFailed
+			status.Error = err.Error()
+			errs = append(errs, err)
+		} else {
+			status.ProcessingStatus = core.ProcessingStatusCompleted
+			status.Error = ""
+		}
+		statuses = append(statuses, status)
+	}
+
+	for _, previous := range apiDefinition.Status.Contexts {
+		if findContextStatus(statuses, previous.Context) != nil {
+			continue
+		}
+		if err := deleteFromContext(ctx, apiDefinition, &previous); err != nil {
+			previous.ProcessingStatus = core.ProcessingStatusFailed
+			previous.Error = err.Error()
+			statuses = append(statuses, previous)
+			errs = append(errs, err)
+		}
+	}
+
+	apiDefinition.Status.Contexts = statuses
+	setCategoriesCondition(&apiDefinition.Status.Status, categories, cp.Generation)
+	apiDefinition.Status.State = cp.Spec.State
+
+	if err := deleteConfigMap(ctx, cp); err != nil {
+		errs = append(errs, err)
+	}
+
+	return kErrors.NewAggregate(errs)
+}
+
+func syncContext(ctx context.Context, api *v1alpha1.ApiV4Definition, status *v4.ContextStatus) error {
+	spec := &api.Spec
+
+	if err := sharedpolicygroups.Resolve(ctx, api); err != nil {
+		return err
+	}
+
+	apimClient, err := apim.FromContextRef(ctx, spec.Context, api.GetNamespace())
+	if err != nil {
+		return err
+	}
+	if err = overlay.Apply(apimClient.Context, api); err != nil {
+		return errors.NewUnrecoverableError(err.Error())
+	}
+	api.PopulateIDs(apimClient.Context)
+
+	if err = checkCategories(apimClient, spec.Categories, &api.Status.Status, api.Generation); err != nil {
+		return err
+	}
+	if err = apimClient.ProvisionMembers(toUsers(spec.Members)...); err != nil {
+		return errors.NewContextError(err)
+	}
+
+	synced, err := apimClient.APIs.ImportV4(&spec.Api)
+	if err != nil {
+		return errors.NewContextError(err)
+	}
+	if err = apimClient.SyncApiPrimaryOwner(synced.ID, spec.PrimaryOwner); err != nil {
+		return errors.NewContextError(err)
+	}
+
+	status.OrgID = synced.OrgID
+	status.EnvID = synced.EnvID
+	status.ID = synced.ID
+	status.CrossID = synced.CrossID
+	status.State = synced.State
+	status.Plans = synced.Plans
+	log.FromContext(ctx).WithValues("id", synced.ID, "context", status.Context).Info("API successfully synced with APIM")
+	return nil
+}
+
+func deleteFromContext(ctx context.Context, api *v1alpha1.ApiV4Definition, status *v4.ContextStatus) error {
+	if status.ID == "" {
+		return nil
+	}
+	namespace, name, _ := strings.Cut(status.Context, "/")
+	ref := refs.NewNamespacedName(namespace, name)
+	if namespace == "" {
+		ref.Kind = core.CRDClusterManagementContextKind
+	}
+	apimClient, err := apim.FromContextRef(ctx, &ref, api.GetNamespace())
+	if err != nil {
+		return err
+	}
+	return errors.IgnoreNotFound(apimClient.APIs.DeleteV4(status.ID))
+}
+
+func findContextStatus(statuses []v4.ContextStatus, context string) *v4.ContextStatus {
+	for i := range statuses {
+		if statuses[i].Context == context {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
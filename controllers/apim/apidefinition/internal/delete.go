@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
@@ -35,6 +36,14 @@ func Delete(ctx context.Context, api core.ApiDefinitionObject) error {
 		}
 	}
 
+	if t, ok := api.(*v1alpha1.ApiV4Definition); ok {
+		for i := range t.Status.Contexts {
+			if err := deleteFromContext(ctx, t, &t.Status.Contexts[i]); err != nil {
+				return err
+			}
+		}
+	}
+
 	util.RemoveFinalizer(api, core.ApiDefinitionFinalizer)
 
 	return nil
@@ -26,7 +26,12 @@ import (
 
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/openapi"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/overlay"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/pages"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/sharedpolicygroups"
 )
 
 func CreateOrUpdate(ctx context.Context, apiDefinition client.Object) error {
@@ -49,6 +54,14 @@ func createOrUpdateV2(ctx context.Context, apiDefinition *v1alpha1.ApiDefinition
 		return err
 	}
 
+	if err := pages.Fetch(ctx, cp); err != nil {
+		return err
+	}
+
+	if err := pages.Resolve(ctx, cp); err != nil {
+		return err
+	}
+
 	cp.PopulateIDs(nil)
 
 	if !apiDefinition.HasContext() {
@@ -70,14 +83,32 @@ func createOrUpdateV2(ctx context.Context, apiDefinition *v1alpha1.ApiDefinition
 		return apimErr
 	}
 
+	if err := overlay.Apply(apimClient.Context, cp); err != nil {
+		return errors.NewUnrecoverableError(err.Error())
+	}
+
+	if err := checkCategories(apimClient, spec.Categories, &apiDefinition.Status.Status, cp.Generation); err != nil {
+		return err
+	}
+	conditions := apiDefinition.Status.Conditions
+
+	if err := apimClient.ProvisionMembers(toUsers(spec.Members)...); err != nil {
+		return errors.NewContextError(err)
+	}
+
 	status, mgmtErr := apimClient.APIs.ImportV2(&spec.Api)
 	if mgmtErr != nil {
 		return errors.NewContextError(mgmtErr)
 	}
 
+	if err := apimClient.SyncApiPrimaryOwner(status.ID, spec.PrimaryOwner); err != nil {
+		return errors.NewContextError(err)
+	}
+
 	apiDefinition.Status = v1alpha1.ApiDefinitionStatus{
 		Status: *status,
 	}
+	apiDefinition.Status.Conditions = conditions
 
 	if spec.IsLocal {
 		return updateConfigMap(ctx, cp)
@@ -100,20 +131,64 @@ func createOrUpdateV4(ctx context.Context, apiDefinition *v1alpha1.ApiV4Definiti
 		return err
 	}
 
+	// shared policy groups are resolved for each context when the API is synced with several of them
+	if !cp.HasContexts() {
+		if err := sharedpolicygroups.Resolve(ctx, cp); err != nil {
+			log.FromContext(ctx).Error(err, "Unable to resolve shared policy groups from references")
+			return err
+		}
+	}
+
+	if err := openapi.Resolve(ctx, cp); err != nil {
+		log.FromContext(ctx).Error(err, "Unable to generate API from its OpenAPI specification")
+		return err
+	}
+
+	if err := pages.Fetch(ctx, cp); err != nil {
+		log.FromContext(ctx).Error(err, "Unable to fetch pages from services")
+		return err
+	}
+
+	if err := pages.Resolve(ctx, cp); err != nil {
+		log.FromContext(ctx).Error(err, "Unable to resolve pages from config maps and secrets")
+		return err
+	}
+
+	if cp.HasContexts() && spec.DefinitionContext == nil {
+		spec.DefinitionContext = &v4.DefinitionContext{SyncFrom: v4.OriginManagement}
+	}
 	spec.DefinitionContext = v4.NewDefaultKubernetesContext().MergeWith(spec.DefinitionContext)
 
+	if cp.HasContexts() {
+		return createOrUpdateV4Contexts(ctx, apiDefinition, cp)
+	}
+
 	if spec.Context != nil {
 		log.FromContext(ctx).Info("Syncing API with APIM")
 		apimClient, err := apim.FromContextRef(ctx, spec.Context, apiDefinition.GetNamespace())
 		if err != nil {
 			return err
 		}
+		if err = overlay.Apply(apimClient.Context, cp); err != nil {
+			return errors.NewUnrecoverableError(err.Error())
+		}
 		cp.PopulateIDs(apimClient.Context)
+		if err = checkCategories(apimClient, spec.Categories, &apiDefinition.Status.Status, cp.Generation); err != nil {
+			return err
+		}
+		conditions := apiDefinition.Status.Conditions
+		if err = apimClient.ProvisionMembers(toUsers(spec.Members)...); err != nil {
+			return err
+		}
 		status, err := apimClient.APIs.ImportV4(&spec.Api)
 		if err != nil {
 			return err
 		}
+		if err = apimClient.SyncApiPrimaryOwner(status.ID, spec.PrimaryOwner); err != nil {
+			return errors.NewContextError(err)
+		}
 		apiDefinition.Status.Status = *status
+		apiDefinition.Status.Conditions = conditions
 		log.FromContext(ctx).WithValues("id", spec.ID).Info("API successfully synced with APIM")
 	} else {
 		cp.PopulateIDs(nil)
@@ -136,3 +211,11 @@ func createOrUpdateV4(ctx context.Context, apiDefinition *v1alpha1.ApiV4Definiti
 	}
 	return nil
 }
+
+func toUsers(members []*base.Member) []*model.User {
+	users := make([]*model.User, 0, len(members))
+	for _, member := range members {
+		users = append(users, model.NewUser(member.Source, member.SourceID))
+	}
+	return users
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 DAVID BRASSELY.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apidefinition
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/pages"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// PageFetcher fetches on a schedule the pages of APIs sourced from services of the cluster.
+// When the hash of the fetched documents changes, the API is annotated with the new hash
+// and is synced again by its reconciler, which fetches the documents as part of the import.
+type PageFetcher struct {
+	client.Client
+	// The name of the controller, which must be unique for the manager
+	Name string
+	// The kind of API definition handled by the controller
+	Object core.ApiDefinitionObject
+}
+
+func (r *PageFetcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	api, _ := r.Object.DeepCopyObject().(core.ApiDefinitionObject)
+	if err := r.Get(ctx, req.NamespacedName, api); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !api.GetDeletionTimestamp().IsZero() || !pages.HasServiceSources(api) {
+		return ctrl.Result{}, nil
+	}
+
+	hash, interval, err := pages.Hash(ctx, api)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Unable to fetch pages from services, retrying on next schedule")
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	if api.GetAnnotations()[core.FetchedPagesHashAnnotation] != hash {
+		patch := client.MergeFrom(api.DeepCopyObject().(client.Object))
+		k8s.AddAnnotation(api, core.FetchedPagesHashAnnotation, hash)
+		if err = r.Patch(ctx, api, patch); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.FromContext(ctx).Info("Pages fetched from services have changed", "hash", hash)
+	}
+
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+func (r *PageFetcher) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(r.Name).
+		For(r.Object).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
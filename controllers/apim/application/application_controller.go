@@ -122,9 +122,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 }
 
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Application{}).
 		Watches(&v1alpha1.ManagementContext{}, r.Watcher.WatchContexts(indexer.AppContextField)).
+		Watches(&v1alpha1.Group{}, r.Watcher.WatchGroups(indexer.AppGroupField))
+	return r.Watcher.WatchClusterContexts(b, indexer.AppContextField).
 		WithEventFilter(predicate.LastSpecHashPredicate{}).
 		Complete(r)
 }
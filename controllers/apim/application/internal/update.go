@@ -17,8 +17,10 @@ package internal
 import (
 	"context"
 
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/application"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
 )
 
@@ -32,11 +34,30 @@ func CreateOrUpdate(ctx context.Context, application *v1alpha1.Application) erro
 
 	application.PopulateIDs(apim.Context)
 
+	if err = apim.ProvisionMembers(toUsers(spec.Members)...); err != nil {
+		return errors.NewContextError(err)
+	}
+
 	status, mgmtErr := apim.Applications.CreateOrUpdate(&spec.Application)
 	if mgmtErr != nil {
 		return errors.NewContextError(mgmtErr)
 	}
 
+	if err = apim.SyncApplicationPrimaryOwner(status.ID, spec.PrimaryOwner); err != nil {
+		return errors.NewContextError(err)
+	}
+
 	status.DeepCopyInto(&application.Status.Status)
 	return nil
 }
+
+func toUsers(members *[]application.Member) []*model.User {
+	if members == nil {
+		return nil
+	}
+	users := make([]*model.User, 0, len(*members))
+	for _, member := range *members {
+		users = append(users, model.NewUser(member.Source, member.SourceID))
+	}
+	return users
+}
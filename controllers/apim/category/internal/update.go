@@ -0,0 +1,70 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+)
+
+func CreateOrUpdate(ctx context.Context, category *v1alpha1.Category) error {
+	spec := &category.Spec
+
+	apim, err := apim.FromContextRef(ctx, spec.Context, category.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	category.PopulateIDs(apim.Context)
+
+	picture, err := resolvePicture(ctx, category)
+	if err != nil {
+		return err
+	}
+
+	synced, mgmtErr := apim.Categories.CreateOrUpdate(&model.Category{
+		ID:          spec.ID,
+		Name:        spec.Name,
+		Description: spec.Description,
+		Order:       spec.Order,
+		Hidden:      spec.Hidden,
+		Page:        spec.HighlightedPage,
+		Picture:     picture,
+	})
+	if mgmtErr != nil {
+		return errors.NewContextError(mgmtErr)
+	}
+
+	category.Status.OrgID = apim.OrgID()
+	category.Status.EnvID = apim.EnvID()
+	category.Status.ID = synced.ID
+	category.Status.Key = synced.Key
+	return nil
+}
+
+// resolvePicture reads the picture of the category, converting raw image bytes to a data URI.
+func resolvePicture(ctx context.Context, category *v1alpha1.Category) (string, error) {
+	picture, err := k8s.ResolveDataURI(ctx, category.Spec.Picture, category.GetNamespace())
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve category picture: %w", err)
+	}
+	return picture, nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 DAVID BRASSELY.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dictionary
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/event"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/predicate"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/template"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/watch"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	util "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/dictionary/internal"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const requeueAfterTime = time.Second * 5
+
+// Reconciler reconciles a Dictionary object.
+type Reconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Watcher  watch.Interface
+}
+
+// +kubebuilder:rbac:groups=gravitee.io,resources=dictionaries,verbs=get;list;watch;create;update;patch;delete;deletecollection
+// +kubebuilder:rbac:groups=gravitee.io,resources=dictionaries/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=gravitee.io,resources=dictionaries/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	dictionary := &v1alpha1.Dictionary{}
+	if err := r.Get(ctx, req.NamespacedName, dictionary); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	events := event.NewRecorder(r.Recorder)
+
+	if dictionary.Spec.Context == nil {
+		logger.Error(fmt.Errorf("no context is provided, no attempt will be made to sync with APIM"), "Aborting reconcile")
+		return ctrl.Result{}, nil
+	}
+
+	dc := dictionary.DeepCopy()
+	_, reconcileErr := util.CreateOrUpdate(ctx, r.Client, dc, func() error {
+		util.AddFinalizer(dictionary, core.DictionaryFinalizer)
+		k8s.AddAnnotation(dictionary, core.LastSpecHashAnnotation, hash.Calculate(&dictionary.Spec))
+
+		if err := template.Compile(ctx, dictionary); err != nil {
+			dictionary.Status.ProcessingStatus = core.ProcessingStatusFailed
+			return err
+		}
+
+		var err error
+		if dictionary.IsBeingDeleted() {
+			err = events.Record(event.Delete, dictionary, func() error {
+				return internal.Delete(ctx, dictionary)
+			})
+		} else {
+			err = events.Record(event.Update, dictionary, func() error {
+				return internal.CreateOrUpdate(ctx, dictionary)
+			})
+		}
+
+		dc.SetFinalizers(dictionary.GetFinalizers())
+		dc.SetAnnotations(dictionary.GetAnnotations())
+		return err
+	})
+
+	dictionary.Status.DeepCopyInto(&dc.Status)
+	if reconcileErr == nil {
+		logger.Info("Dictionary has been reconciled")
+		return ctrl.Result{}, internal.UpdateStatusSuccess(ctx, dc)
+	}
+
+	// An error occurred during the reconcile
+	if err := internal.UpdateStatusFailure(ctx, dc); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if errors.IsRecoverable(reconcileErr) {
+		logger.Error(reconcileErr, "Requeuing reconcile")
+		return ctrl.Result{RequeueAfter: requeueAfterTime}, reconcileErr
+	}
+
+	logger.Error(reconcileErr, "Aborting reconcile")
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.Dictionary{}).
+		Watches(&v1alpha1.ManagementContext{}, r.Watcher.WatchContexts(indexer.DictionaryContextField)).
+		Watches(&corev1.ConfigMap{}, r.Watcher.WatchConfigMaps(indexer.DictionaryConfigMapField)).
+		Watches(&corev1.Secret{}, r.Watcher.WatchSecrets(indexer.DictionarySecretField))
+	return r.Watcher.WatchClusterContexts(b, indexer.DictionaryContextField).
+		WithEventFilter(predicate.LastSpecHashPredicate{}).
+		Complete(r)
+}
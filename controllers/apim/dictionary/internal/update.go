@@ -0,0 +1,119 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/dictionary"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	properties "github.com/gravitee-io/gravitee-kubernetes-operator/internal/dictionary"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+)
+
+func CreateOrUpdate(ctx context.Context, dict *v1alpha1.Dictionary) error {
+	spec := &dict.Spec
+
+	apim, err := apim.FromContextRef(ctx, spec.Context, dict.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	dict.PopulateIDs(apim.Context)
+
+	props, err := properties.ResolveProperties(ctx, dict)
+	if err != nil {
+		return err
+	}
+
+	desired := &model.Dictionary{
+		ID:          spec.ID,
+		Name:        spec.Name,
+		Description: spec.Description,
+		Type:        string(spec.Kind),
+		Properties:  props,
+		Provider:    toProvider(spec.Provider),
+		Trigger:     toTrigger(spec.Trigger),
+	}
+	deploymentHash := getDeploymentHash(desired)
+
+	synced, mgmtErr := apim.Dictionaries.CreateOrUpdate(desired)
+	if mgmtErr != nil {
+		return errors.NewContextError(mgmtErr)
+	}
+
+	dict.Status.OrgID = apim.OrgID()
+	dict.Status.EnvID = apim.EnvID()
+	dict.Status.ID = synced.ID
+	dict.Status.Key = synced.Key
+	dict.Status.State = synced.State
+
+	if synced.IsStarted() && dict.Status.DeploymentHash == deploymentHash {
+		return nil
+	}
+
+	deployed, mgmtErr := apim.Dictionaries.Deploy(synced)
+	if mgmtErr != nil {
+		return errors.NewContextError(mgmtErr)
+	}
+
+	dict.Status.State = deployed.State
+	dict.Status.DeploymentHash = deploymentHash
+	return nil
+}
+
+// getDeploymentHash identifies the content of the dictionary that is made available to the gateways,
+// so that the dictionary gets deployed again only when this content changes.
+func getDeploymentHash(dict *model.Dictionary) string {
+	return hash.Calculate(&model.Dictionary{
+		Type:       dict.Type,
+		Properties: dict.Properties,
+		Provider:   dict.Provider,
+		Trigger:    dict.Trigger,
+	})
+}
+
+func toProvider(provider *dictionary.Provider) *model.DictionaryProvider {
+	if provider == nil {
+		return nil
+	}
+
+	headers := make([]model.DictionaryHeader, len(provider.Headers))
+	for i, header := range provider.Headers {
+		headers[i] = model.DictionaryHeader{Name: header.Name, Value: header.Value}
+	}
+
+	return &model.DictionaryProvider{
+		Type: provider.Type,
+		Configuration: &model.DictionaryProviderConfiguration{
+			URL:            provider.URL,
+			Method:         provider.Method,
+			Headers:        headers,
+			Body:           provider.Body,
+			Specification:  provider.Specification,
+			UseSystemProxy: provider.UseSystemProxy,
+		},
+	}
+}
+
+func toTrigger(trigger *dictionary.Trigger) *model.DictionaryTrigger {
+	if trigger == nil {
+		return nil
+	}
+	return &model.DictionaryTrigger{Rate: trigger.Rate, Unit: trigger.Unit}
+}
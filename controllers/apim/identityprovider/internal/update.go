@@ -0,0 +1,142 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+)
+
+func CreateOrUpdate(ctx context.Context, idp *v1alpha1.IdentityProvider) error {
+	spec := &idp.Spec
+
+	apim, err := apim.FromContextRef(ctx, spec.Context, idp.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	configuration, err := resolveConfiguration(ctx, idp)
+	if err != nil {
+		return err
+	}
+
+	groupMappings, err := toGroupMappings(apim, spec)
+	if err != nil {
+		return err
+	}
+
+	synced, mgmtErr := apim.IdentityProviders.CreateOrUpdate(&model.IdentityProvider{
+		ID:                 idp.Status.ID,
+		Name:               spec.Name,
+		Description:        spec.Description,
+		Type:               string(spec.Kind),
+		Enabled:            spec.Enabled,
+		Configuration:      configuration,
+		EmailRequired:      spec.EmailRequired,
+		SyncMappings:       spec.SyncMappings,
+		UserProfileMapping: toProfileMapping(spec),
+		GroupMappings:      groupMappings,
+		RoleMappings:       toRoleMappings(spec),
+	})
+	if mgmtErr != nil {
+		return errors.NewContextError(mgmtErr)
+	}
+
+	if err = apim.IdentityProviders.SetActivated(synced.ID, spec.Activated); err != nil {
+		return errors.NewContextError(err)
+	}
+
+	idp.Status.OrgID = apim.OrgID()
+	idp.Status.ID = synced.ID
+	idp.Status.Activated = spec.Activated
+	return nil
+}
+
+// resolveConfiguration returns the inline configuration of the identity provider,
+// overridden by the entries read from config maps and secrets.
+func resolveConfiguration(ctx context.Context, idp *v1alpha1.IdentityProvider) (map[string]interface{}, error) {
+	configuration := make(map[string]interface{})
+	if idp.Spec.Configuration != nil {
+		configuration = idp.Spec.Configuration.DeepCopy().Object
+	}
+
+	for key, from := range idp.Spec.ConfigurationFrom {
+		value, err := k8s.ResolveValue(ctx, from, idp.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve configuration property [%s]: %w", key, err)
+		}
+		configuration[key] = string(value)
+	}
+
+	return configuration, nil
+}
+
+func toProfileMapping(spec *v1alpha1.IdentityProviderSpec) *model.IdentityProviderProfileMapping {
+	mapping := spec.UserProfileMapping
+	if mapping == nil {
+		return nil
+	}
+	return &model.IdentityProviderProfileMapping{
+		ID:        mapping.ID,
+		FirstName: mapping.FirstName,
+		LastName:  mapping.LastName,
+		Email:     mapping.Email,
+		Picture:   mapping.Picture,
+	}
+}
+
+// Groups are referenced by name and looked up in the environment of the context.
+func toGroupMappings(
+	apim *apim.APIM,
+	spec *v1alpha1.IdentityProviderSpec,
+) ([]model.IdentityProviderGroupMapping, error) {
+	mappings := make([]model.IdentityProviderGroupMapping, 0, len(spec.GroupMappings))
+	for _, mapping := range spec.GroupMappings {
+		ids := make([]string, 0, len(mapping.Groups))
+		for _, name := range mapping.Groups {
+			group, err := apim.Env.FindGroup(name)
+			if err != nil {
+				return nil, errors.NewContextError(err)
+			}
+			if group == nil {
+				return nil, fmt.Errorf("group [%s] of identity provider mapping does not exist", name)
+			}
+			ids = append(ids, group.ID)
+		}
+		mappings = append(mappings, model.IdentityProviderGroupMapping{
+			Condition: mapping.Condition,
+			Groups:    ids,
+		})
+	}
+	return mappings, nil
+}
+
+func toRoleMappings(spec *v1alpha1.IdentityProviderSpec) []model.IdentityProviderRoleMapping {
+	mappings := make([]model.IdentityProviderRoleMapping, 0, len(spec.RoleMappings))
+	for _, mapping := range spec.RoleMappings {
+		mappings = append(mappings, model.IdentityProviderRoleMapping{
+			Condition:     mapping.Condition,
+			Organizations: mapping.Organizations,
+			Environments:  mapping.Environments,
+		})
+	}
+	return mappings
+}
@@ -57,6 +57,10 @@ type Reconciler struct {
 
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingressclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gravitee.io,resources=graviteeingressclassparameters,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
 
 // Reconcile perform reconciliation logic for Ingress resource that is managed
 // by the operator.
@@ -68,6 +72,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	// ingress classes are resolved here rather than in the event filter,
+	// ingresses already managed by the operator are kept until their finalizer is removed
+	if !util.ContainsFinalizer(ingress, core.IngressFinalizer) && !k8s.IsGraviteeIngress(ctx, ingress) {
+		return ctrl.Result{}, nil
+	}
+
 	events := e.NewRecorder(r.Recorder)
 	_, reconcileErr := util.CreateOrUpdate(ctx, r.Client, ingress, func() error {
 		util.AddFinalizer(ingress, core.IngressFinalizer)
@@ -79,12 +89,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 		if !ingress.DeletionTimestamp.IsZero() {
 			return events.Record(e.Delete, ingress, func() error {
-				return internal.Delete(ctx, ingress)
+				return internal.Delete(ctx, ingress, events)
 			})
 		}
 
 		return events.Record(e.Update, ingress, func() error {
-			return internal.CreateOrUpdate(ctx, ingress)
+			return internal.CreateOrUpdate(ctx, ingress, events)
 		})
 	})
 
@@ -93,6 +103,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, reconcileErr
 	}
 
+	if ingress.DeletionTimestamp.IsZero() {
+		if err := k8s.UpdateIngressStatus(ctx, ingress); err != nil {
+			logger.Error(err, "Unable to update the Ingress status", "Ingress", ingress)
+			return ctrl.Result{}, err
+		}
+	}
+
 	logger.Info("Sync ingress DONE")
 	return ctrl.Result{}, nil
 }
@@ -101,11 +118,13 @@ func (r *Reconciler) ingressClassEventFilter() predicate.Predicate {
 	reconcilable := func(o runtime.Object) bool {
 		switch t := o.(type) {
 		case *netV1.Ingress:
-			return k8s.IsGraviteeIngress(t)
+			return k8s.GetIngressClassName(t) != ""
 		case *v1alpha1.ApiDefinition:
 			return t.GetAnnotations()[core.IngressTemplateAnnotation] == env.TrueString
 		case *corev1.Secret:
 			return t.Type == "kubernetes.io/tls"
+		case *v1alpha1.GraviteeIngressClassParameters:
+			return true
 		default:
 			return false
 		}
@@ -138,6 +157,8 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&v1alpha1.ApiDefinition{}).
 		Watches(&v1alpha1.ApiDefinition{}, r.Watcher.WatchApiTemplate()).
 		Watches(&corev1.Secret{}, r.Watcher.WatchTLSSecret()).
+		Watches(&netV1.Ingress{}, r.Watcher.WatchCanaryIngresses()).
+		Watches(&v1alpha1.GraviteeIngressClassParameters{}, r.Watcher.WatchIngressClassParameters()).
 		WithEventFilter(r.ingressClassEventFilter()).
 		Complete(r)
 }
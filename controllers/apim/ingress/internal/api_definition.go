@@ -96,6 +96,36 @@ func updateApiDefinition(
 	return util.OperationResultUpdated, cli.Update(ctx, existingApiDefinition)
 }
 
+// Canary ingresses share the API generated for the ingresses exposing the same hosts
+// and paths, so the API generated before an ingress became a canary is deleted.
+func deleteApiDefinition(ctx context.Context, ingress *v1.Ingress) error {
+	nsm := types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}
+	apiDefinition, err := getApiDefinition(ctx, nsm)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !isOwnedBy(apiDefinition, ingress) {
+		return nil
+	}
+
+	log.FromContext(ctx).Info("Deleting ApiDefinition of canary ingress", "name", nsm.Name, "namespace", nsm.Namespace)
+	return client.IgnoreNotFound(k8s.GetClient().Delete(ctx, apiDefinition))
+}
+
+func isOwnedBy(apiDefinition *v1alpha1.ApiDefinition, ingress *v1.Ingress) bool {
+	for _, ref := range apiDefinition.GetOwnerReferences() {
+		if ref.UID == ingress.UID {
+			return true
+		}
+	}
+	return false
+}
+
 func getApiDefinition(ctx context.Context, key client.ObjectKey) (*v1alpha1.ApiDefinition, error) {
 	api := &v1alpha1.ApiDefinition{}
 	cli := k8s.GetClient()
@@ -18,16 +18,20 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 
-	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/ingress/internal/mapper"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/ingress/mapper"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/search"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
 	v2 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v2"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
 	coreV1 "k8s.io/api/core/v1"
 	netV1 "k8s.io/api/networking/v1"
@@ -37,39 +41,161 @@ func resolveApiDefinitionTemplate(
 	ctx context.Context,
 	ingress *netV1.Ingress,
 ) (*v1alpha1.ApiDefinition, error) {
-	var apiDefinition *v1alpha1.ApiDefinition
+	params, err := k8s.GetIngressClassParameters(ctx, ingress)
+	if err != nil {
+		return nil, err
+	}
+
+	apiDefinition, err := getApiDefinitionTemplate(ctx, ingress, params)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := getMapperOpts(ctx, ingress, params)
+	if err != nil {
+		return nil, err
+	}
+
+	api := mapper.New(opts).Map(apiDefinition, ingress)
+
+	if params != nil && api.Spec.Context == nil {
+		api.Spec.Context = params.ResolveRef(params.Spec.Context)
+	}
+
+	return api, nil
+}
+
+func getApiDefinitionTemplate(
+	ctx context.Context,
+	ingress *netV1.Ingress,
+	params *v1alpha1.GraviteeIngressClassParameters,
+) (*v1alpha1.ApiDefinition, error) {
+	var key types.NamespacedName
 
 	if name, ok := ingress.Annotations[core.IngressTemplateAnnotation]; ok {
-		apiDefinition = &v1alpha1.ApiDefinition{}
-		cli := k8s.GetClient()
-		if err := cli.Get(
-			ctx, types.NamespacedName{Name: name, Namespace: ingress.Namespace}, apiDefinition,
-		); err != nil {
-			return nil, err
-		}
+		key = types.NamespacedName{Name: name, Namespace: ingress.Namespace}
+	} else if params != nil && params.Spec.Template != nil {
+		key = params.ResolveRef(params.Spec.Template).NamespacedName()
 	} else {
-		apiDefinition = defaultApiDefinitionTemplate()
+		return defaultApiDefinitionTemplate(), nil
+	}
+
+	apiDefinition := &v1alpha1.ApiDefinition{}
+	if err := k8s.GetClient().Get(ctx, key, apiDefinition); err != nil {
+		return nil, err
 	}
 
-	return mapper.New(getMapperOpts(ctx)).Map(apiDefinition, ingress), nil
+	return apiDefinition, nil
 }
 
-func getMapperOpts(ctx context.Context) mapper.Opts {
+func getMapperOpts(
+	ctx context.Context,
+	ingress *netV1.Ingress,
+	params *v1alpha1.GraviteeIngressClassParameters,
+) (mapper.Opts, error) {
 	opts := mapper.NewOpts()
-	setNotFoundTemplate(ctx, &opts)
-	return opts
+	setNotFoundTemplate(ctx, &opts, params)
+	if params != nil {
+		opts.ResponseTemplates = params.Spec.ResponseTemplates
+	}
+
+	canaries, err := getCanaries(ctx, ingress)
+	if err != nil {
+		return opts, err
+	}
+	opts.Canaries = canaries
+
+	return opts, nil
 }
 
-func setNotFoundTemplate(ctx context.Context, opts *mapper.Opts) {
+// Canaries are the ingresses of the same namespace and class annotated as canaries
+// for one of the hosts and paths of the ingress, sorted by name so that the generated API
+// does not depend on the listing order.
+func getCanaries(ctx context.Context, ingress *netV1.Ingress) ([]mapper.Canary, error) {
+	found := make(map[string]*netV1.Ingress)
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			ingresses := &netV1.IngressList{}
+			ref := refs.NewNamespacedName(ingress.Namespace, rule.Host+path.Path)
+			if err := search.FindByFieldReferencing(ctx, indexer.CanaryPathField, ref, ingresses); err != nil {
+				return nil, err
+			}
+			for i := range ingresses.Items {
+				found[ingresses.Items[i].Name] = &ingresses.Items[i]
+			}
+		}
+	}
+
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	canaries := make([]mapper.Canary, 0)
+	for _, name := range names {
+		canary := found[name]
+		if !canary.DeletionTimestamp.IsZero() ||
+			k8s.GetIngressClassName(canary) != k8s.GetIngressClassName(ingress) {
+			continue
+		}
+
+		weight, err := k8s.GetCanaryWeight(canary)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "ignoring canary ingress", "canary", canary.Name)
+			continue
+		}
+
+		canaries = append(canaries, mapper.Canary{Ingress: canary, Weight: weight})
+	}
+
+	return canaries, nil
+}
+
+func setNotFoundTemplate(
+	ctx context.Context,
+	opts *mapper.Opts,
+	params *v1alpha1.GraviteeIngressClassParameters,
+) {
+	if params != nil && params.Spec.NotFoundTemplate != nil {
+		setNotFoundTemplateFromParameters(ctx, opts, params)
+		return
+	}
+
 	ns, name := env.Config.CMTemplate404NS, env.Config.CMTemplate404Name
 
 	if name == "" {
 		return
 	}
 
+	setNotFoundTemplateFromConfigMap(ctx, opts, types.NamespacedName{Namespace: ns, Name: name})
+}
+
+func setNotFoundTemplateFromParameters(
+	ctx context.Context,
+	opts *mapper.Opts,
+	params *v1alpha1.GraviteeIngressClassParameters,
+) {
+	template := params.Spec.NotFoundTemplate
+
+	if template.ConfigMap != nil {
+		setNotFoundTemplateFromConfigMap(ctx, opts, params.ResolveRef(template.ConfigMap).NamespacedName())
+		return
+	}
+
+	opts.Templates[http.StatusNotFound] = mapper.ResponseTemplate{
+		Content:     template.Content,
+		ContentType: template.ContentType,
+	}
+}
+
+func setNotFoundTemplateFromConfigMap(ctx context.Context, opts *mapper.Opts, key types.NamespacedName) {
 	cm := coreV1.ConfigMap{}
 	cli := k8s.GetClient()
-	if err := cli.Get(ctx, types.NamespacedName{Namespace: ns, Name: name}, &cm); err != nil {
+	if err := cli.Get(ctx, key, &cm); err != nil {
 		log.FromContext(ctx).Error(err, "unable to access config map, using default HTTP not found template")
 		return
 	}
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/event"
 	v1 "k8s.io/api/networking/v1"
 	util "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -25,12 +26,18 @@ import (
 
 func Delete(
 	ctx context.Context,
-	ingress *v1.Ingress) error {
+	ingress *v1.Ingress,
+	events *event.Recorder) error {
 	if err := deleteIngressTLSReference(ctx, ingress); err != nil {
 		log.FromContext(ctx).Error(err, "An error occurred while updating the TLS secrets")
 		return err
 	}
 
+	if err := updateKeystore(ctx, ingress, events); err != nil {
+		log.FromContext(ctx).Error(err, "An error occurred while updating the keystore")
+		return err
+	}
+
 	util.RemoveFinalizer(ingress, core.IngressFinalizer)
 
 	return nil
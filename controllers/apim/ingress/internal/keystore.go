@@ -0,0 +1,277 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	core1 "github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/event"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/gateway"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/keystore"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/search"
+	core "k8s.io/api/core/v1"
+	netV1 "k8s.io/api/networking/v1"
+	kErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	util "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const keystoreSourceSkippedReason = "KeystoreSourceSkipped"
+
+// keystoreSource holds the TLS data used to build a keystore entry,
+// and is used to compute the hash of the keystore content.
+type keystoreSource struct {
+	Alias string
+	Cert  []byte
+	Key   []byte
+}
+
+// updateKeystore rebuilds the keystore of the class of the ingress, if any,
+// from the TLS secrets of all the ingresses of this class. Secrets that are missing
+// or do not hold a valid key pair are skipped, and reported as warnings on the ingress
+// referencing them, so that they do not prevent other hosts from being served.
+func updateKeystore(ctx context.Context, ingress *netV1.Ingress, events *event.Recorder) error {
+	params, err := k8s.GetIngressClassParameters(ctx, ingress)
+	if err != nil || params == nil || params.Spec.Keystore == nil {
+		return err
+	}
+
+	config := newKeystoreConfig(params)
+	if err = config.Validate(); err != nil {
+		return err
+	}
+
+	password, err := resolveKeystorePassword(ctx, config.Password)
+	if err != nil {
+		return err
+	}
+
+	sources, err := getKeystoreSources(ctx, k8s.GetIngressClassName(ingress), events)
+	if err != nil {
+		return err
+	}
+
+	return writeKeystore(ctx, params, config, sources, password)
+}
+
+func newKeystoreConfig(params *v1alpha1.GraviteeIngressClassParameters) gateway.KeystoreConfig {
+	ks := params.Spec.Keystore
+	return gateway.KeystoreConfig{
+		Type:     ks.Type,
+		Password: ks.Password,
+		Location: gateway.NewGraviteeKubeProperty(
+			gateway.SecretKubePropertyType, params.Namespace, ks.SecretName, ks.Key,
+		),
+	}
+}
+
+func resolveKeystorePassword(ctx context.Context, password string) (string, error) {
+	prop := gateway.GraviteeKubeProperty(password)
+	if !prop.IsKubeProperty() {
+		return password, nil
+	}
+
+	receiver := prop.NewReceiver()
+	key := types.NamespacedName{Namespace: prop.Namespace(), Name: prop.Name()}
+	if err := k8s.GetClient().Get(ctx, key, receiver); err != nil {
+		return "", err
+	}
+
+	value := prop.Get(receiver)
+	if value == nil {
+		return "", fmt.Errorf("unable to find keystore password at %s", prop)
+	}
+
+	return string(value), nil
+}
+
+// Each host of an ingress TLS definition is added as an alias of the keystore,
+// so that the gateway can select the certificate using SNI. When no host is defined,
+// the entry is aliased using the namespace and name of the secret.
+func getKeystoreSources(
+	ctx context.Context,
+	ingressClassName string,
+	events *event.Recorder,
+) ([]keystoreSource, error) {
+	ingresses := &netV1.IngressList{}
+	ref := refs.NewNamespacedName("", ingressClassName)
+	if err := search.FindByFieldReferencing(ctx, indexer.IngressClassField, ref, ingresses); err != nil {
+		return nil, err
+	}
+
+	sources := make(map[string]keystoreSource)
+	for i := range ingresses.Items {
+		ing := &ingresses.Items[i]
+		if !ing.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := addKeystoreSources(ctx, ing, sources, events); err != nil {
+			return nil, err
+		}
+	}
+
+	result := make([]keystoreSource, 0, len(sources))
+	for _, source := range sources {
+		result = append(result, source)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Alias < result[j].Alias
+	})
+
+	return result, nil
+}
+
+func addKeystoreSources(
+	ctx context.Context,
+	ing *netV1.Ingress,
+	sources map[string]keystoreSource,
+	events *event.Recorder,
+) error {
+	for _, ingressTLS := range ing.Spec.TLS {
+		secret := &core.Secret{}
+		key := types.NamespacedName{Namespace: ing.Namespace, Name: ingressTLS.SecretName}
+		err := k8s.GetClient().Get(ctx, key, secret)
+		if kErrors.IsNotFound(err) {
+			skipKeystoreSource(ctx, ing, events, fmt.Sprintf("TLS secret %s not found", key))
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err = tls.X509KeyPair(secret.Data[core.TLSCertKey], secret.Data[core.TLSPrivateKeyKey]); err != nil {
+			skipKeystoreSource(ctx, ing, events, fmt.Sprintf("TLS secret %s holds an invalid key pair: %s", key, err))
+			continue
+		}
+
+		aliases := ingressTLS.Hosts
+		if len(aliases) == 0 {
+			aliases = []string{fmt.Sprintf("%s-%s", secret.Namespace, secret.Name)}
+		}
+
+		for _, alias := range aliases {
+			alias = strings.ToLower(alias)
+			if _, ok := sources[alias]; ok {
+				log.FromContext(ctx).Info("keystore alias is already defined, skipping", "alias", alias)
+				continue
+			}
+			sources[alias] = keystoreSource{
+				Alias: alias,
+				Cert:  secret.Data[core.TLSCertKey],
+				Key:   secret.Data[core.TLSPrivateKeyKey],
+			}
+		}
+	}
+
+	return nil
+}
+
+func skipKeystoreSource(ctx context.Context, ing *netV1.Ingress, events *event.Recorder, message string) {
+	log.FromContext(ctx).Info("skipping keystore source", "ingress", ing.Name, "reason", message)
+	events.Warn(ing, keystoreSourceSkippedReason, message+", it has not been added to the keystore")
+}
+
+func writeKeystore(
+	ctx context.Context,
+	params *v1alpha1.GraviteeIngressClassParameters,
+	config gateway.KeystoreConfig,
+	sources []keystoreSource,
+	password string,
+) error {
+	contentHash := hash.Calculate(struct {
+		Type     string
+		Password string
+		Sources  []keystoreSource
+	}{config.Type, password, sources})
+
+	secret := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.Location.Name(),
+			Namespace: config.Location.Namespace(),
+		},
+	}
+
+	cli := k8s.GetClient()
+	_, err := util.CreateOrUpdate(ctx, cli, secret, func() error {
+		if secret.Annotations[core1.LastSpecHashAnnotation] == contentHash {
+			return nil
+		}
+
+		entries, err := newKeystoreEntries(sources)
+		if err != nil {
+			return err
+		}
+
+		data, err := keystore.NewEncoder().Encode(config.Type, entries, password)
+		if err != nil {
+			return err
+		}
+
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		secret.Data[config.Location.Key()] = data
+
+		k8s.AddAnnotation(secret, core1.LastSpecHashAnnotation, contentHash)
+		if secret.Labels == nil {
+			secret.Labels = make(map[string]string)
+		}
+		secret.Labels[core1.GraviteeComponentLabel] = core1.GraviteeKeystoreLabel
+
+		return util.SetOwnerReference(params, secret, cli.Scheme())
+	})
+
+	return err
+}
+
+func newKeystoreEntries(sources []keystoreSource) ([]keystore.Entry, error) {
+	entries := make([]keystore.Entry, 0, len(sources))
+	for _, source := range sources {
+		keyPair, err := tls.X509KeyPair(source.Cert, source.Key)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load key pair for alias %s: %w", source.Alias, err)
+		}
+
+		chain := make([]*x509.Certificate, 0, len(keyPair.Certificate))
+		for _, der := range keyPair.Certificate {
+			cert, pErr := x509.ParseCertificate(der)
+			if pErr != nil {
+				return nil, pErr
+			}
+			chain = append(chain, cert)
+		}
+
+		entries = append(entries, keystore.Entry{
+			Alias: source.Alias,
+			Key:   keyPair.PrivateKey,
+			Chain: chain,
+		})
+	}
+	return entries, nil
+}
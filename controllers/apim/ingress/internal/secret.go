@@ -24,6 +24,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
 
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
@@ -33,6 +35,8 @@ import (
 	core1 "github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
 	core "k8s.io/api/core/v1"
 	netV1 "k8s.io/api/networking/v1"
+	kErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	util "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -69,12 +73,14 @@ func updateIngressTLSReference(
 			log.FromContext(ctx).Info("adding finalizer to the tls secret")
 
 			secret.ObjectMeta.Finalizers = append(secret.ObjectMeta.Finalizers, core1.KeyPairFinalizer)
+			k8s.AddLabel(secret, core1.CertificateValidationLabel, env.TrueString)
 			k8s.AddAnnotation(secret, core1.LastSpecHashAnnotation, hash.Calculate(&secret.Data))
 			if err := cli.Update(ctx, secret); err != nil {
 				return client.IgnoreNotFound(err)
 			}
 		} else {
 			secret.Annotations[core1.LastSpecHashAnnotation] = hash.Calculate(&secret.Data)
+			k8s.AddLabel(secret, core1.CertificateValidationLabel, env.TrueString)
 			if err := cli.Update(ctx, secret); err != nil {
 				return err
 			}
@@ -130,6 +136,9 @@ func deleteIngressTLSReference(
 		} else {
 			log.FromContext(ctx).Info("removing finalizer from secret", "secret", secret.Name)
 			util.RemoveFinalizer(secret, core1.KeyPairFinalizer)
+			if !util.ContainsFinalizer(secret, core1.TemplatingFinalizer) {
+				delete(secret.Labels, core1.CertificateValidationLabel)
+			}
 
 			if err = cli.Update(ctx, secret); err != nil {
 				return err
@@ -176,7 +185,7 @@ func retrieveIngressListWithTLS(ctx context.Context, ns string) (*netV1.IngressL
 	result := &netV1.IngressList{}
 	for i := range il.Items {
 		ingress := il.Items[i]
-		if k8s.IsGraviteeIngress(&ingress) {
+		if k8s.IsGraviteeIngress(ctx, &ingress) {
 			if ingress.Spec.TLS != nil {
 				result.Items = append(result.Items, ingress)
 			}
@@ -189,36 +198,45 @@ func retrieveIngressListWithTLS(ctx context.Context, ns string) (*netV1.IngressL
 func updatePemRegistry(
 	ctx context.Context,
 	ing *netV1.Ingress, key string, values []string) error {
-	pemRegistriesToUpdate, err := getPemRegistryConfigMapsToUpdate(ctx, ing)
+	deleting := !ing.DeletionTimestamp.IsZero()
+	pemRegistriesToUpdate, err := getPemRegistryConfigMapsToUpdate(ctx, ing, !deleting)
 	if err != nil {
 		return err
 	}
 
-	if !ing.DeletionTimestamp.IsZero() {
+	if deleting {
 		return deletePemRegistryEntry(ctx, pemRegistriesToUpdate, key)
 	}
 
 	return updatePemRegistryEntry(ctx, pemRegistriesToUpdate, key, values)
 }
 
+// getPemRegistryConfigMapsToUpdate returns the pem registries of the class of the ingress.
+// Missing registries are only created when create is true, there is nothing to remove
+// from a registry that does not exist.
 func getPemRegistryConfigMapsToUpdate(
 	ctx context.Context,
-	ing *netV1.Ingress) ([]*core.ConfigMap, error) {
+	ing *netV1.Ingress,
+	create bool) ([]*core.ConfigMap, error) {
+	params, err := k8s.GetIngressClassParameters(ctx, ing)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil && params.Spec.PemRegistry != nil {
+		return getPemRegistryConfigMapFromParameters(ctx, ing, params, create)
+	}
+
 	pemRegistryConfigMaps := &core.ConfigMapList{}
 	filter := &client.ListOptions{
 		LabelSelector: labels.SelectorFromSet(labels.Set{core1.GraviteeComponentLabel: core1.GraviteePemRegistryLabel}),
 	}
-	var err error
 
 	cli := k8s.GetClient()
 	if err = cli.List(ctx, pemRegistryConfigMaps, filter); err != nil {
 		return nil, err
 	}
 
-	if len(pemRegistryConfigMaps.Items) == 0 {
-		return nil, fmt.Errorf("unable to find any pem-registry configmap in the cluster")
-	}
-
 	pemRegistriesToUpdate := make([]*core.ConfigMap, 0)
 	for i := range pemRegistryConfigMaps.Items {
 		item := pemRegistryConfigMaps.Items[i]
@@ -228,7 +246,87 @@ func getPemRegistryConfigMapsToUpdate(
 		}
 	}
 
-	return pemRegistriesToUpdate, nil
+	if len(pemRegistriesToUpdate) > 0 || !create {
+		return pemRegistriesToUpdate, nil
+	}
+
+	key := types.NamespacedName{
+		Namespace: getPemRegistryNamespace(ing, params),
+		Name:      fmt.Sprintf("%s-%s", k8s.GetIngressClassName(ing), core1.GraviteePemRegistryLabel),
+	}
+
+	pemRegistry, err := createPemRegistry(ctx, ing, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*core.ConfigMap{pemRegistry}, nil
+}
+
+func getPemRegistryConfigMapFromParameters(
+	ctx context.Context,
+	ing *netV1.Ingress,
+	params *v1alpha1.GraviteeIngressClassParameters,
+	create bool) ([]*core.ConfigMap, error) {
+	configMap := &core.ConfigMap{}
+	key := params.ResolveRef(params.Spec.PemRegistry).NamespacedName()
+	err := k8s.GetClient().Get(ctx, key, configMap)
+	if kErrors.IsNotFound(err) && !create {
+		return nil, nil
+	}
+	if kErrors.IsNotFound(err) {
+		configMap, err = createPemRegistry(ctx, ing, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []*core.ConfigMap{configMap}, nil
+}
+
+// The registry is created in the namespace of the ingress class parameters, falling back
+// to the namespace watched by the operator, and then to the namespace of the ingress.
+func getPemRegistryNamespace(ing *netV1.Ingress, params *v1alpha1.GraviteeIngressClassParameters) string {
+	if params != nil {
+		return params.Namespace
+	}
+	if env.Config.NS != "" {
+		return env.Config.NS
+	}
+	return ing.Namespace
+}
+
+// createPemRegistry creates a pem registry config map for the class of the ingress.
+// The config map is owned by the ingress class, if any, so that it gets garbage collected
+// when the class is deleted.
+func createPemRegistry(
+	ctx context.Context,
+	ing *netV1.Ingress,
+	key types.NamespacedName) (*core.ConfigMap, error) {
+	ingressClassName := k8s.GetIngressClassName(ing)
+	pemRegistry := &core.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			Labels: map[string]string{
+				core1.GraviteeComponentLabel: core1.GraviteePemRegistryLabel,
+				core1.IngressClassAnnotation: ingressClassName,
+			},
+		},
+		Data: map[string]string{},
+	}
+
+	cli := k8s.GetClient()
+	ingressClass := &netV1.IngressClass{}
+	err := cli.Get(ctx, types.NamespacedName{Name: ingressClassName}, ingressClass)
+	if err == nil {
+		err = util.SetOwnerReference(ingressClass, pemRegistry, cli.Scheme())
+	}
+	if client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+
+	log.FromContext(ctx).Info("Creating PEM registry", "name", key.Name, "namespace", key.Namespace)
+	return pemRegistry, cli.Create(ctx, pemRegistry)
 }
 
 // parse K8S TLS secret and make sure it is valid.
@@ -17,18 +17,30 @@ package internal
 import (
 	"context"
 
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/event"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
 	v1 "k8s.io/api/networking/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 func CreateOrUpdate(
 	ctx context.Context,
-	desired *v1.Ingress) error {
+	desired *v1.Ingress,
+	events *event.Recorder) error {
 	if err := updateIngressTLSReference(ctx, desired); err != nil {
 		log.FromContext(ctx).Error(err, "An error occurred while updating the PEM registry")
 		return err
 	}
 
+	if err := updateKeystore(ctx, desired, events); err != nil {
+		log.FromContext(ctx).Error(err, "An error occurred while updating the keystore")
+		return err
+	}
+
+	if k8s.IsCanaryIngress(desired) {
+		return deleteApiDefinition(ctx, desired)
+	}
+
 	operation, apiDefinitionError := createOrUpdateApiDefinition(ctx, desired)
 	if apiDefinitionError != nil {
 		log.FromContext(ctx).Error(
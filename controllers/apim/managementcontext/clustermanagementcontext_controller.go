@@ -0,0 +1,92 @@
+/*
+ * Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package managementcontext
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/managementcontext/internal"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/event"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/predicate"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	util "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+)
+
+// ClusterReconciler reconciles a ClusterManagementContext object.
+// Templates are not compiled for cluster contexts as they do not belong to any namespace.
+type ClusterReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=gravitee.io,resources=clustermanagementcontexts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gravitee.io,resources=clustermanagementcontexts/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=gravitee.io,resources=clustermanagementcontexts/finalizers,verbs=update
+func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	clusterContext := &v1alpha1.ClusterManagementContext{}
+	if err := r.Get(ctx, req.NamespacedName, clusterContext); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	events := event.NewRecorder(r.Recorder)
+	dc := clusterContext.DeepCopy()
+	_, reconcileErr := util.CreateOrUpdate(ctx, r.Client, dc, func() error {
+		util.AddFinalizer(clusterContext, core.ClusterContextFinalizer)
+		k8s.AddAnnotation(clusterContext, core.LastSpecHashAnnotation, hash.Calculate(&clusterContext.Spec))
+
+		var err error
+		if clusterContext.IsBeingDeleted() {
+			err = events.Record(event.Delete, clusterContext, func() error {
+				return internal.Delete(ctx, clusterContext, core.ClusterContextFinalizer)
+			})
+		} else {
+			err = events.Record(event.Update, clusterContext, func() error {
+				// Resources referencing the context are synced when they watch an update of the context
+				return nil
+			})
+		}
+
+		clusterContext.ObjectMeta.DeepCopyInto(&dc.ObjectMeta)
+		return err
+	})
+
+	if reconcileErr == nil {
+		logger.Info("Cluster management context has been reconciled")
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{}, reconcileErr
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ClusterManagementContext{}).
+		WithEventFilter(predicate.LastSpecHashPredicate{}).
+		Complete(r)
+}
@@ -53,6 +53,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=gravitee.io,resources=managementcontexts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=gravitee.io,resources=managementcontexts/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=gravitee.io,resources=managementcontexts/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	managementContext := &v1alpha1.ManagementContext{}
@@ -73,7 +74,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		var err error
 		if managementContext.IsBeingDeleted() {
 			err = events.Record(event.Delete, managementContext, func() error {
-				return internal.Delete(ctx, managementContext)
+				return internal.Delete(ctx, managementContext, core.ManagementContextFinalizer)
 			})
 		} else {
 			err = events.Record(event.Update, managementContext, func() error {
@@ -0,0 +1,77 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/search"
+	util "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func Delete(
+	ctx context.Context,
+	page *v1alpha1.PortalPage,
+) error {
+	if !util.ContainsFinalizer(page, core.PortalPageFinalizer) {
+		return nil
+	}
+
+	if err := checkChildren(ctx, page); err != nil {
+		return err
+	}
+
+	apim, apimErr := apim.FromContextRef(ctx, page.Spec.Context, page.GetNamespace())
+	if apimErr != nil {
+		return apimErr
+	}
+
+	if page.Status.ID != "" {
+		if err := apim.Portal.DeletePage(page.Status.ID); errors.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	util.RemoveFinalizer(page, core.PortalPageFinalizer)
+
+	return nil
+}
+
+// A folder is only deleted once the pages it contains have been deleted,
+// as deleting the folder in APIM would leave these pages orphaned.
+func checkChildren(ctx context.Context, page *v1alpha1.PortalPage) error {
+	children := &v1alpha1.PortalPageList{}
+	if err := search.FindByFieldReferencing(
+		ctx,
+		indexer.PortalPageParentField,
+		refs.NewNamespacedName(page.Namespace, page.Name),
+		children,
+	); err != nil {
+		return fmt.Errorf("an error occurred while checking if the portal page contains other pages: %w", err)
+	}
+
+	if count := len(children.Items); count > 0 {
+		return fmt.Errorf("can not delete %s because %d portal page(s) are relying on this folder", page.Name, count)
+	}
+
+	return nil
+}
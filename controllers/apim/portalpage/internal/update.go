@@ -0,0 +1,137 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/portalpage"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+)
+
+func CreateOrUpdate(ctx context.Context, page *v1alpha1.PortalPage) error {
+	spec := &page.Spec
+
+	apim, err := apim.FromContextRef(ctx, spec.Context, page.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	parentID, err := resolveParent(ctx, page)
+	if err != nil {
+		return err
+	}
+
+	content, err := resolveContent(ctx, page)
+	if err != nil {
+		return err
+	}
+
+	accessControls, err := toAccessControls(apim, &spec.Type)
+	if err != nil {
+		return err
+	}
+
+	synced, mgmtErr := apim.Portal.CreateOrUpdatePage(&model.PortalPage{
+		ID:                     page.Status.ID,
+		Name:                   spec.Name,
+		Type:                   spec.Kind,
+		Content:                content,
+		Order:                  spec.Order,
+		Published:              spec.Published,
+		Visibility:             spec.Visibility,
+		HomePage:               spec.HomePage,
+		ParentID:               parentID,
+		Source:                 toSource(&spec.Type),
+		Configuration:          spec.Configuration,
+		AccessControls:         accessControls,
+		ExcludedAccessControls: spec.ExcludedAccessControl,
+	})
+	if mgmtErr != nil {
+		return errors.NewContextError(mgmtErr)
+	}
+
+	page.Status.OrgID = apim.OrgID()
+	page.Status.EnvID = apim.EnvID()
+	page.Status.ID = synced.ID
+	page.Status.ParentID = parentID
+	return nil
+}
+
+// The parent folder is another PortalPage resource, which must have been synced first.
+func resolveParent(ctx context.Context, page *v1alpha1.PortalPage) (string, error) {
+	ref := page.GetParentRef()
+	if ref == nil {
+		return "", nil
+	}
+
+	parent := new(v1alpha1.PortalPage)
+	if err := k8s.GetClient().Get(ctx, ref.NamespacedName(), parent); err != nil {
+		return "", fmt.Errorf("unable to resolve parent folder [%s] of portal page: %w", ref, err)
+	}
+
+	if parent.Status.ID == "" {
+		return "", fmt.Errorf("parent folder [%s] of portal page has not been synced yet", ref)
+	}
+
+	return parent.Status.ID, nil
+}
+
+func resolveContent(ctx context.Context, page *v1alpha1.PortalPage) (string, error) {
+	if page.Spec.ContentFrom == nil {
+		return page.Spec.Content, nil
+	}
+
+	content, err := k8s.ResolveValue(ctx, page.Spec.ContentFrom, page.GetNamespace())
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve content of portal page: %w", err)
+	}
+
+	return string(content), nil
+}
+
+// Groups are referenced by name and looked up in the environment of the context.
+func toAccessControls(apim *apim.APIM, spec *portalpage.Type) ([]model.PortalPageAccessControl, error) {
+	accessControls := make([]model.PortalPageAccessControl, 0, len(spec.AccessControls))
+	for _, ac := range spec.AccessControls {
+		group, err := apim.Env.FindGroup(ac.ReferenceID)
+		if err != nil {
+			return nil, errors.NewContextError(err)
+		}
+		if group == nil {
+			return nil, fmt.Errorf("group [%s] of portal page access controls does not exist", ac.ReferenceID)
+		}
+		accessControls = append(accessControls, model.PortalPageAccessControl{
+			ReferenceID:   group.ID,
+			ReferenceType: ac.ReferenceType,
+		})
+	}
+	return accessControls, nil
+}
+
+func toSource(spec *portalpage.Type) *model.PageSource {
+	if spec.Source == nil {
+		return nil
+	}
+	return &model.PageSource{
+		Type:          spec.Source.Type,
+		Configuration: spec.Source.Configuration,
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 DAVID BRASSELY.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portalpage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/event"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/predicate"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/template"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/watch"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	util "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/portalpage/internal"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const requeueAfterTime = time.Second * 5
+
+// Reconciler reconciles a PortalPage object.
+type Reconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Watcher  watch.Interface
+}
+
+// +kubebuilder:rbac:groups=gravitee.io,resources=portalpages,verbs=get;list;watch;create;update;patch;delete;deletecollection
+// +kubebuilder:rbac:groups=gravitee.io,resources=portalpages/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=gravitee.io,resources=portalpages/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	page := &v1alpha1.PortalPage{}
+	if err := r.Get(ctx, req.NamespacedName, page); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	events := event.NewRecorder(r.Recorder)
+
+	if page.Spec.Context == nil {
+		logger.Error(fmt.Errorf("no context is provided, no attempt will be made to sync with APIM"), "Aborting reconcile")
+		return ctrl.Result{}, nil
+	}
+
+	dc := page.DeepCopy()
+	_, reconcileErr := util.CreateOrUpdate(ctx, r.Client, dc, func() error {
+		util.AddFinalizer(page, core.PortalPageFinalizer)
+		k8s.AddAnnotation(page, core.LastSpecHashAnnotation, hash.Calculate(&page.Spec))
+
+		if err := template.Compile(ctx, page); err != nil {
+			page.Status.ProcessingStatus = core.ProcessingStatusFailed
+			return err
+		}
+
+		var err error
+		if page.IsBeingDeleted() {
+			err = events.Record(event.Delete, page, func() error {
+				return internal.Delete(ctx, page)
+			})
+		} else {
+			err = events.Record(event.Update, page, func() error {
+				return internal.CreateOrUpdate(ctx, page)
+			})
+		}
+
+		dc.SetFinalizers(page.GetFinalizers())
+		dc.SetAnnotations(page.GetAnnotations())
+		return err
+	})
+
+	page.Status.DeepCopyInto(&dc.Status)
+	if reconcileErr == nil {
+		logger.Info("Portal page has been reconciled")
+		return ctrl.Result{}, internal.UpdateStatusSuccess(ctx, dc)
+	}
+
+	// An error occurred during the reconcile
+	if err := internal.UpdateStatusFailure(ctx, dc); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if errors.IsRecoverable(reconcileErr) {
+		logger.Error(reconcileErr, "Requeuing reconcile")
+		return ctrl.Result{RequeueAfter: requeueAfterTime}, reconcileErr
+	}
+
+	logger.Error(reconcileErr, "Aborting reconcile")
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.PortalPage{}).
+		Watches(&v1alpha1.ManagementContext{}, r.Watcher.WatchContexts(indexer.PortalPageContextField)).
+		Watches(&corev1.ConfigMap{}, r.Watcher.WatchConfigMaps(indexer.PortalPageConfigMapField)).
+		Watches(&corev1.Secret{}, r.Watcher.WatchSecrets(indexer.PortalPageSecretField)).
+		Watches(&v1alpha1.PortalPage{}, r.Watcher.WatchPortalPages(indexer.PortalPageParentField))
+	return r.Watcher.WatchClusterContexts(b, indexer.PortalPageContextField).
+		WithEventFilter(predicate.LastSpecHashPredicate{}).
+		Complete(r)
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/portaltheme"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+)
+
+func CreateOrUpdate(ctx context.Context, theme *v1alpha1.PortalTheme) error {
+	spec := &theme.Spec
+
+	apim, err := apim.FromContextRef(ctx, spec.Context, theme.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	current, err := apim.Portal.CurrentTheme()
+	if err != nil {
+		return errors.NewContextError(err)
+	}
+
+	defaults, err := apim.Portal.DefaultTheme()
+	if err != nil {
+		return errors.NewContextError(err)
+	}
+
+	desired, err := toTheme(ctx, theme, current, defaults)
+	if err != nil {
+		return err
+	}
+
+	synced, mgmtErr := apim.Portal.UpdateTheme(desired)
+	if mgmtErr != nil {
+		return errors.NewContextError(mgmtErr)
+	}
+
+	links, err := apim.Portal.SyncMenuLinks(toMenuLinks(spec.Navigation), theme.Status.Links)
+	theme.Status.Links = links
+	if err != nil {
+		return errors.NewContextError(err)
+	}
+
+	theme.Status.OrgID = apim.OrgID()
+	theme.Status.EnvID = apim.EnvID()
+	theme.Status.ID = synced.ID
+	return nil
+}
+
+// toTheme builds the theme applied to the portal from the spec,
+// values that are not set in the spec being taken from the default theme.
+func toTheme(
+	ctx context.Context,
+	theme *v1alpha1.PortalTheme,
+	current, defaults *model.PortalTheme,
+) (*model.PortalTheme, error) {
+	spec := &theme.Spec
+
+	definition := &model.PortalThemeDefinition{
+		Color: &model.PortalThemeColors{},
+		Font:  &model.PortalThemeFont{},
+	}
+	if defaults.Definition != nil {
+		if defaults.Definition.Color != nil {
+			*definition.Color = *defaults.Definition.Color
+		}
+		if defaults.Definition.Font != nil {
+			*definition.Font = *defaults.Definition.Font
+		}
+	}
+
+	mergeColors(definition.Color, spec.Colors)
+	if spec.FontFamily != "" {
+		definition.Font.FontFamily = spec.FontFamily
+	}
+
+	css, err := k8s.ResolveValue(ctx, spec.CustomCSSFrom, theme.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve custom CSS: %w", err)
+	}
+	definition.CustomCSS = strings.TrimSpace(strings.Join([]string{spec.CustomCSS, string(css)}, "\n"))
+
+	logo, err := resolveImage(ctx, theme, spec.Logo, defaults.Logo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve portal logo: %w", err)
+	}
+
+	favicon, err := resolveImage(ctx, theme, spec.Favicon, defaults.Favicon)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve portal favicon: %w", err)
+	}
+
+	name := spec.Name
+	if name == "" {
+		name = current.Name
+	}
+
+	return &model.PortalTheme{
+		ID:           current.ID,
+		Name:         name,
+		Type:         model.PortalNextThemeType,
+		Enabled:      true,
+		Definition:   definition,
+		Logo:         logo,
+		OptionalLogo: current.OptionalLogo,
+		Favicon:      favicon,
+	}, nil
+}
+
+func mergeColors(colors *model.PortalThemeColors, overrides *portaltheme.Colors) {
+	if overrides == nil {
+		return
+	}
+	for _, color := range []struct {
+		target *string
+		value  string
+	}{
+		{&colors.Primary, overrides.Primary},
+		{&colors.Secondary, overrides.Secondary},
+		{&colors.Tertiary, overrides.Tertiary},
+		{&colors.Error, overrides.Error},
+		{&colors.PageBackground, overrides.PageBackground},
+		{&colors.CardBackground, overrides.CardBackground},
+	} {
+		if color.value != "" {
+			*color.target = color.value
+		}
+	}
+}
+
+func resolveImage(
+	ctx context.Context,
+	theme *v1alpha1.PortalTheme,
+	from *refs.ValueFrom,
+	defaultValue string,
+) (string, error) {
+	if from == nil {
+		return defaultValue, nil
+	}
+	return k8s.ResolveDataURI(ctx, from, theme.Namespace)
+}
+
+func toMenuLinks(links []*portaltheme.Link) []*model.PortalMenuLink {
+	menuLinks := make([]*model.PortalMenuLink, len(links))
+	for i, link := range links {
+		menuLinks[i] = &model.PortalMenuLink{
+			Name:       link.Name,
+			Type:       model.ExternalPortalMenuLinkType,
+			Target:     link.Target,
+			Visibility: string(link.Visibility),
+			Order:      i + 1,
+		}
+	}
+	return menuLinks
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	util "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func Delete(
+	ctx context.Context,
+	role *v1alpha1.Role,
+) error {
+	if !util.ContainsFinalizer(role, core.RoleFinalizer) {
+		return nil
+	}
+
+	apim, apimErr := apim.FromContextRef(ctx, role.Spec.Context, role.GetNamespace())
+	if apimErr != nil {
+		return apimErr
+	}
+
+	if role.Status.ID != "" {
+		existing, err := apim.Roles.Find(string(role.Spec.Scope), role.Spec.Name)
+		if err != nil {
+			return err
+		}
+
+		// System roles (e.g. ADMIN or PRIMARY_OWNER) are owned by APIM and never deleted
+		if existing != nil && !existing.System {
+			if err = apim.Roles.Delete(string(role.Spec.Scope), existing.Name); errors.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+
+	util.RemoveFinalizer(role, core.RoleFinalizer)
+
+	return nil
+}
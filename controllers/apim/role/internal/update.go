@@ -0,0 +1,60 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+)
+
+func CreateOrUpdate(ctx context.Context, role *v1alpha1.Role) error {
+	spec := &role.Spec
+
+	apim, err := apim.FromContextRef(ctx, spec.Context, role.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	synced, mgmtErr := apim.Roles.CreateOrUpdate(&model.RoleDefinition{
+		Name:        spec.Name,
+		Description: spec.Description,
+		Scope:       string(spec.Scope),
+		Default:     spec.Default,
+		Permissions: toPermissions(spec),
+	})
+	if mgmtErr != nil {
+		return errors.NewContextError(mgmtErr)
+	}
+
+	role.Status.OrgID = apim.OrgID()
+	role.Status.ID = synced.ID
+	return nil
+}
+
+func toPermissions(spec *v1alpha1.RoleSpec) map[string][]string {
+	permissions := make(map[string][]string, len(spec.Permissions))
+	for name, rights := range spec.Permissions {
+		values := make([]string, 0, len(rights))
+		for _, right := range rights {
+			values = append(values, string(right))
+		}
+		permissions[name] = values
+	}
+	return permissions
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"time"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/certificate"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/event"
+	v1 "k8s.io/api/core/v1"
+	util "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// Secrets are monitored as long as the operator relies on them,
+// either as ingress key pairs, through templating or from management contexts.
+var monitoredFinalizers = []string{
+	core.KeyPairFinalizer,
+	core.TemplatingFinalizer,
+	core.ManagementContextSecretFinalizer,
+}
+
+const maxMonitoringDelay = 24 * time.Hour
+
+// MonitorCertificates exposes the validity period of the certificates held by the secret as metrics,
+// and emits a warning event for each certificate that is expired or close to its expiry date.
+// Warnings are emitted once per certificate each time a new threshold is crossed.
+// The returned delay is the time after which the secret should be checked again, or zero.
+func MonitorCertificates(secret *v1.Secret, recorder *event.Recorder) time.Duration {
+	if !secret.DeletionTimestamp.IsZero() || !isMonitored(secret) {
+		certificate.Forget(secret.Namespace, secret.Name)
+		certificate.Retain(secret.Namespace, secret.Name, nil)
+		return 0
+	}
+
+	infos := certificate.Find(secret)
+	certificate.Record(secret.Namespace, secret.Name, infos)
+	certificate.Retain(secret.Namespace, secret.Name, infos)
+
+	now := time.Now()
+	var next time.Duration
+	for _, info := range infos {
+		warning, delay := certificate.CheckExpiry(info, env.Config.CertificateExpiryThresholds, now)
+		if certificate.ShouldNotify(secret.Namespace, secret.Name, info, warning) {
+			recorder.Warn(secret, warning.Reason, warning.Message)
+		}
+		if delay > 0 && (next == 0 || delay < next) {
+			next = delay
+		}
+	}
+
+	if next > maxMonitoringDelay {
+		return maxMonitoringDelay
+	}
+
+	return next
+}
+
+func isMonitored(secret *v1.Secret) bool {
+	for _, finalizer := range monitoredFinalizers {
+		if util.ContainsFinalizer(secret, finalizer) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+)
+
+func CreateOrUpdate(ctx context.Context, tag *v1alpha1.ShardingTag) error {
+	spec := &tag.Spec
+
+	apim, err := apim.FromContextRef(ctx, spec.Context, tag.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	groups, err := resolveGroups(apim, spec.RestrictedGroups)
+	if err != nil {
+		return err
+	}
+
+	synced, mgmtErr := apim.ShardingTags.CreateOrUpdate(&model.ShardingTag{
+		Name:             spec.Name,
+		Description:      spec.Description,
+		RestrictedGroups: groups,
+	})
+	if mgmtErr != nil {
+		return errors.NewContextError(mgmtErr)
+	}
+
+	tag.Status.OrgID = apim.OrgID()
+	tag.Status.ID = synced.ID
+	return nil
+}
+
+// resolveGroups returns the IDs of the given groups, looked up by name in the environment of the context.
+func resolveGroups(apim *apim.APIM, names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		group, err := apim.Env.FindGroup(name)
+		if err != nil {
+			return nil, errors.NewContextError(err)
+		}
+		if group == nil {
+			return nil, fmt.Errorf("restricted group [%s] does not exist", name)
+		}
+		ids = append(ids, group.ID)
+	}
+	return ids, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/search"
+	util "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func Delete(
+	ctx context.Context,
+	group *v1alpha1.SharedPolicyGroup,
+) error {
+	if !util.ContainsFinalizer(group, core.SharedPolicyGroupFinalizer) {
+		return nil
+	}
+
+	apis := &v1alpha1.ApiV4DefinitionList{}
+	if err := search.FindByFieldReferencing(
+		ctx,
+		indexer.ApiV4SharedPolicyGroupField,
+		refs.NewNamespacedName(group.Namespace, group.Name),
+		apis,
+	); err != nil {
+		return fmt.Errorf("can not check if the shared policy group is used by an api v4 definition: %w", err)
+	}
+
+	if len(apis.Items) > 0 {
+		return fmt.Errorf("can not delete %s because %d api(s) are using this shared policy group",
+			group.Name, len(apis.Items))
+	}
+
+	apim, apimErr := apim.FromContextRef(ctx, group.Spec.Context, group.GetNamespace())
+	if apimErr != nil {
+		return apimErr
+	}
+
+	if group.Status.ID != "" {
+		if _, err := apim.SharedPolicyGroups.Undeploy(group.Status.ID); errors.IgnoreNotFound(err) != nil {
+			return err
+		}
+		if err := apim.SharedPolicyGroups.Delete(group.Status.ID); errors.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	util.RemoveFinalizer(group, core.SharedPolicyGroupFinalizer)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+)
+
+// CreateOrUpdate syncs the shared policy group with APIM and deploys it,
+// so that every update of the resource is rolled out to the APIs using the group.
+func CreateOrUpdate(ctx context.Context, group *v1alpha1.SharedPolicyGroup) error {
+	spec := &group.Spec
+
+	apim, err := apim.FromContextRef(ctx, spec.Context, group.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	group.PopulateIDs(apim.Context)
+
+	steps := spec.Steps
+	if steps == nil {
+		steps = []*v4.FlowStep{}
+	}
+
+	synced, mgmtErr := apim.SharedPolicyGroups.CreateOrUpdate(&model.SharedPolicyGroup{
+		ID:                  group.Status.ID,
+		CrossID:             spec.CrossID,
+		Name:                spec.Name,
+		Description:         spec.Description,
+		PrerequisiteMessage: spec.PrerequisiteMessage,
+		ApiType:             string(spec.ApiType),
+		Phase:               string(spec.Phase),
+		Steps:               steps,
+	})
+	if mgmtErr != nil {
+		return errors.NewContextError(mgmtErr)
+	}
+
+	group.Status.OrgID = apim.OrgID()
+	group.Status.EnvID = apim.EnvID()
+	group.Status.ID = synced.ID
+	group.Status.CrossID = synced.CrossID
+
+	deployed, mgmtErr := apim.SharedPolicyGroups.Deploy(synced.ID)
+	if mgmtErr != nil {
+		return errors.NewContextError(mgmtErr)
+	}
+
+	group.Status.State = deployed.LifecycleState
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base
+
+import (
+	"context"
+	"slices"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ValidateCategories checks that the given categories either exist in APIM or are defined
+// by a Category resource in the given namespace. The categories that exist in APIM are returned,
+// so that categories pending their sync can be left out of a dry run import.
+func ValidateCategories(
+	ctx context.Context,
+	apim *apim.APIM,
+	namespace string,
+	categories []string,
+) (*errors.AdmissionErrors, []string) {
+	errs := errors.NewAdmissionErrors()
+
+	missing, err := apim.Categories.FindMissing(categories)
+	if err != nil {
+		errs.AddWarningf("unable to validate API categories: %s", err.Error())
+		return errs, categories
+	}
+
+	if len(missing) == 0 {
+		return errs, categories
+	}
+
+	defined, err := getCategoryResources(ctx, namespace)
+	if err != nil {
+		errs.AddSevere(err.Error())
+		return errs, categories
+	}
+
+	for _, category := range missing {
+		if slices.Contains(defined, category) {
+			errs.AddWarningf("category [%s] has not been synced with APIM yet", category)
+		} else {
+			errs.AddSeveref(
+				"category [%s] does not exist in APIM, create it or define the corresponding Category resource",
+				category,
+			)
+		}
+	}
+
+	existing := slices.DeleteFunc(slices.Clone(categories), func(category string) bool {
+		return slices.Contains(missing, category)
+	})
+
+	return errs, existing
+}
+
+func getCategoryResources(ctx context.Context, namespace string) ([]string, error) {
+	list := &v1alpha1.CategoryList{}
+	if err := k8s.GetClient().List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	defined := make([]string, 0)
+	for _, category := range list.Items {
+		defined = append(defined, category.Spec.Name)
+		if category.Status.Key != "" {
+			defined = append(defined, category.Status.Key)
+		}
+	}
+
+	return defined, nil
+}
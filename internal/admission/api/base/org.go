@@ -0,0 +1,188 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ValidateShardingTags checks that the given sharding tags either exist in the organization of the context
+// or are defined by a ShardingTag resource in the given namespace, and that the user of the context
+// is allowed to deploy APIs on them. The tags pending their sync are returned,
+// so that they can be left out of a dry run import.
+func ValidateShardingTags(
+	ctx context.Context,
+	apim *apim.APIM,
+	namespace string,
+	tags []string,
+) (*errors.AdmissionErrors, []string) {
+	errs := errors.NewAdmissionErrors()
+	pending := make([]string, 0)
+	if len(tags) == 0 {
+		return errs, pending
+	}
+
+	existing, err := apim.ShardingTags.List()
+	if err != nil {
+		errs.AddWarningf("unable to validate sharding tags: %s", err.Error())
+		return errs, pending
+	}
+
+	ids := make([]string, 0, len(existing))
+	for _, tag := range existing {
+		ids = append(ids, tag.ID)
+	}
+
+	defined, err := getShardingTagResources(ctx, namespace)
+	if err != nil {
+		errs.AddSevere(err.Error())
+		return errs, pending
+	}
+
+	for _, tag := range tags {
+		switch {
+		case slices.Contains(ids, tag):
+			continue
+		case slices.Contains(defined, strings.ToLower(tag)):
+			errs.AddWarningf("sharding tag [%s] has not been synced with APIM yet", tag)
+			pending = append(pending, tag)
+		default:
+			errs.AddSeveref(
+				"sharding tag [%s] does not exist in APIM, create it or define the corresponding ShardingTag resource",
+				tag,
+			)
+		}
+	}
+
+	if errs.IsSevere() {
+		return errs, pending
+	}
+
+	errs.MergeWith(validateAllowedShardingTags(apim, tags, pending))
+
+	return errs, pending
+}
+
+// Users can only deploy APIs on the tags that are not restricted to groups they are not a member of.
+func validateAllowedShardingTags(apim *apim.APIM, tags []string, pending []string) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+
+	allowed, err := apim.ShardingTags.ListAllowed()
+	if err != nil {
+		errs.AddWarningf("unable to validate the sharding tags allowed for the management context: %s", err.Error())
+		return errs
+	}
+
+	for _, tag := range tags {
+		if !slices.Contains(pending, tag) && !slices.Contains(allowed, tag) {
+			errs.AddSeveref("the management context is not allowed to deploy APIs on sharding tag [%s]", tag)
+		}
+	}
+
+	return errs
+}
+
+// ValidateTenants checks that the given tenants either exist in the organization of the context
+// or are defined by a Tenant resource in the given namespace.
+func ValidateTenants(
+	ctx context.Context,
+	apim *apim.APIM,
+	namespace string,
+	tenants []string,
+) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+
+	missing, err := apim.Tenants.FindMissing(tenants)
+	if err != nil {
+		errs.AddWarningf("unable to validate endpoint tenants: %s", err.Error())
+		return errs
+	}
+
+	if len(missing) == 0 {
+		return errs
+	}
+
+	defined, err := getTenantResources(ctx, namespace)
+	if err != nil {
+		errs.AddSevere(err.Error())
+		return errs
+	}
+
+	for _, tenant := range missing {
+		if slices.Contains(defined, strings.ToLower(tenant)) {
+			errs.AddWarningf("tenant [%s] has not been synced with APIM yet", tenant)
+		} else {
+			errs.AddSeveref(
+				"tenant [%s] does not exist in APIM, create it or define the corresponding Tenant resource", tenant,
+			)
+		}
+	}
+
+	return errs
+}
+
+// WithoutPending returns the given values, leaving out the pending ones.
+func WithoutPending(values []string, pending []string) []string {
+	if len(pending) == 0 || values == nil {
+		return values
+	}
+	return slices.DeleteFunc(slices.Clone(values), func(value string) bool {
+		return slices.Contains(pending, value)
+	})
+}
+
+// APIM derives the ID of tags and tenants from their name, which is why
+// resources are matched either by the ID they have been given or by their name.
+func getShardingTagResources(ctx context.Context, namespace string) ([]string, error) {
+	list := &v1alpha1.ShardingTagList{}
+	if err := k8s.GetClient().List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	defined := make([]string, 0)
+	for _, tag := range list.Items {
+		defined = append(defined, strings.ToLower(tag.Spec.Name))
+		if tag.Status.ID != "" {
+			defined = append(defined, strings.ToLower(tag.Status.ID))
+		}
+	}
+
+	return defined, nil
+}
+
+func getTenantResources(ctx context.Context, namespace string) ([]string, error) {
+	list := &v1alpha1.TenantList{}
+	if err := k8s.GetClient().List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	defined := make([]string, 0)
+	for _, tenant := range list.Items {
+		defined = append(defined, strings.ToLower(tenant.Spec.Name))
+		if tenant.Status.ID != "" {
+			defined = append(defined, strings.ToLower(tenant.Status.ID))
+		}
+	}
+
+	return defined, nil
+}
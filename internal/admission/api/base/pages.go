@@ -0,0 +1,76 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/pages"
+)
+
+// validatePages checks that the content of pages sourced from config maps and secrets can be resolved,
+// and that the content of SWAGGER and ASYNCAPI pages can be parsed.
+// Pages fetched from services are not fetched here, so that admission does not depend on
+// the services of the cluster: only their configuration is validated.
+func validatePages(ctx context.Context, api core.ApiDefinitionObject) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+
+	declared := pages.Get(api)
+	for _, key := range sortedKeys(declared) {
+		page := declared[key]
+		if !pages.IsServiceSourced(page) {
+			continue
+		}
+		if page.Type == base.FolderPageType {
+			errs.AddSeveref("page [%s] is a folder and cannot be fetched from a service", key)
+		}
+		if _, err := pages.NewServiceSource(api.GetNamespace(), page.Source.Configuration); err != nil {
+			errs.AddSeveref("page [%s] has an invalid %s source: %s", key, pages.ServiceFetcherType, err.Error())
+		}
+	}
+	if errs.IsSevere() {
+		return errs
+	}
+
+	cp, _ := api.DeepCopyObject().(core.ApiDefinitionObject)
+	pages.RemoveServiceSources(cp)
+
+	if err := pages.Resolve(ctx, cp); err != nil {
+		errs.AddSevere(err.Error())
+		return errs
+	}
+
+	apiPages := pages.Get(cp)
+	contextPaths := cp.GetContextPaths()
+	for _, key := range sortedKeys(apiPages) {
+		page := apiPages[key]
+		errs.MergeWith(ValidatePageContent(ctx, key, page.Type, page.Content, contextPaths))
+	}
+
+	return errs
+}
+
+func sortedKeys(apiPages map[string]*base.Page) []string {
+	keys := make([]string, 0, len(apiPages))
+	for key := range apiPages {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -24,7 +24,9 @@ import (
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s/dynamic"
 )
 
-func validateNoConflictingPath(ctx context.Context, api core.ApiDefinitionObject) *errors.AdmissionError {
+// ValidateNoConflictingPath checks that the context paths of the API are not
+// already used by another API definition of the same namespace.
+func ValidateNoConflictingPath(ctx context.Context, api core.ApiDefinitionObject) *errors.AdmissionError {
 	apiPaths := api.GetContextPaths()
 	existingPaths, err := getExistingPaths(ctx, api)
 	if err != nil {
@@ -0,0 +1,195 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/openapi"
+)
+
+const refKey = "$ref"
+
+// ValidatePageContent parses the content of SWAGGER and ASYNCAPI pages.
+// Syntax and schema errors are reported as severe, whereas unresolved references
+// and servers that do not match any context path of the API are reported as warnings.
+// Pages of other types are not validated.
+func ValidatePageContent(
+	ctx context.Context,
+	key, pageType, content string,
+	contextPaths []string,
+) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if strings.TrimSpace(content) == "" || (pageType != base.SwaggerPageType && pageType != base.AsyncAPIPageType) {
+		return errs
+	}
+
+	raw, doc, err := openapi.Parse([]byte(content))
+	if err != nil {
+		errs.AddSeveref("page [%s] cannot be parsed: %s", key, err.Error())
+		return errs
+	}
+
+	unresolved := findUnresolvedRefs(doc)
+	for _, ref := range unresolved {
+		errs.AddWarningf("page [%s] references [%s] which cannot be resolved", key, ref)
+	}
+
+	if pageType == base.AsyncAPIPageType {
+		errs.MergeWith(validateAsyncAPI(key, doc))
+		return errs
+	}
+
+	spec, err := openapi.Load(ctx, doc, raw)
+	if err != nil {
+		// schema errors caused by unresolved references have already been reported
+		if len(unresolved) == 0 {
+			errs.AddSeveref("page [%s] is not a valid OpenAPI document: %s", key, err.Error())
+		}
+		return errs
+	}
+
+	if len(unresolved) == 0 {
+		if err = spec.Validate(ctx); err != nil {
+			errs.AddSeveref("page [%s] is not a valid OpenAPI document: %s", key, err.Error())
+			return errs
+		}
+	}
+
+	errs.Add(validateServers(key, openapi.ServerPaths(doc, spec), contextPaths))
+	return errs
+}
+
+// AsyncAPI documents are checked against the structure shared by versions 2 and 3 of the specification.
+func validateAsyncAPI(key string, doc map[string]interface{}) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+
+	version, _ := doc["asyncapi"].(string)
+	if !strings.HasPrefix(version, "2.") && !strings.HasPrefix(version, "3.") {
+		errs.AddSeveref("page [%s] must define an asyncapi version 2.x or 3.x, got [%v]", key, doc["asyncapi"])
+		return errs
+	}
+
+	info, ok := doc["info"].(map[string]interface{})
+	if !ok {
+		errs.AddSeveref("page [%s] is not a valid AsyncAPI document: info is required", key)
+		return errs
+	}
+	for _, field := range []string{"title", "version"} {
+		if value, ok := info[field].(string); !ok || value == "" {
+			errs.AddSeveref("page [%s] is not a valid AsyncAPI document: info.%s is required", key, field)
+		}
+	}
+
+	channels, hasChannels := doc["channels"]
+	if _, ok = channels.(map[string]interface{}); hasChannels && !ok {
+		errs.AddSeveref("page [%s] is not a valid AsyncAPI document: channels must be an object", key)
+	} else if !hasChannels && strings.HasPrefix(version, "2.") {
+		errs.AddSeveref("page [%s] is not a valid AsyncAPI document: channels are required", key)
+	}
+
+	return errs
+}
+
+func validateServers(key string, servers, contextPaths []string) *errors.AdmissionError {
+	if len(servers) == 0 || len(contextPaths) == 0 {
+		return nil
+	}
+
+	known := make(map[string]bool, len(contextPaths))
+	for _, contextPath := range contextPaths {
+		if i := strings.Index(contextPath, "/"); i > 0 {
+			// context paths of virtual hosts are prefixed with the host
+			contextPath = contextPath[i:]
+		}
+		known[openapi.NormalizePath(contextPath)] = true
+	}
+
+	for _, server := range servers {
+		if server == "/" || known[server] {
+			return nil
+		}
+	}
+
+	return errors.NewWarningf(
+		"servers of page [%s] %v do not match any context path of the API %v", key, servers, contextPaths,
+	)
+}
+
+// findUnresolvedRefs returns the references of the document that either point
+// to another document or to a location that does not exist in the document.
+func findUnresolvedRefs(doc map[string]interface{}) []string {
+	unresolved := make(map[string]bool)
+	var walk func(node interface{})
+	walk = func(node interface{}) {
+		switch t := node.(type) {
+		case map[string]interface{}:
+			if ref, ok := t[refKey].(string); ok && !resolvesLocally(doc, ref) {
+				unresolved[ref] = true
+			}
+			for _, child := range t {
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range t {
+				walk(child)
+			}
+		}
+	}
+	walk(doc)
+
+	refs := make([]string, 0, len(unresolved))
+	for ref := range unresolved {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+	return refs
+}
+
+func resolvesLocally(doc map[string]interface{}, ref string) bool {
+	if !strings.HasPrefix(ref, "#") {
+		return false
+	}
+
+	var node interface{} = doc
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/") {
+		if token == "" {
+			continue
+		}
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch t := node.(type) {
+		case map[string]interface{}:
+			child, ok := t[token]
+			if !ok {
+				return false
+			}
+			node = child
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(t) {
+				return false
+			}
+			node = t[index]
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"slices"
+
+	v2 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v2"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+)
+
+// validateShardingTagsAndTenants validates the sharding tags of the API and its plans
+// and the tenants of its endpoints, leaving the tags pending their sync out of the dry run import.
+func validateShardingTagsAndTenants(
+	ctx context.Context,
+	apim *apim.APIM,
+	namespace string,
+	api *v2.Api,
+) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+
+	tagErrs, pending := base.ValidateShardingTags(ctx, apim, namespace, getShardingTags(api))
+	errs.MergeWith(tagErrs)
+	errs.MergeWith(base.ValidateTenants(ctx, apim, namespace, getTenants(api)))
+
+	api.Tags = base.WithoutPending(api.Tags, pending)
+	for _, plan := range api.Plans {
+		if plan != nil && plan.Plan != nil {
+			plan.Tags = base.WithoutPending(plan.Tags, pending)
+		}
+	}
+
+	return errs
+}
+
+func getShardingTags(api *v2.Api) []string {
+	tags := slices.Clone(api.Tags)
+	for _, plan := range api.Plans {
+		if plan != nil && plan.Plan != nil {
+			tags = append(tags, plan.Tags...)
+		}
+	}
+	slices.Sort(tags)
+	return slices.Compact(tags)
+}
+
+func getTenants(api *v2.Api) []string {
+	tenants := make([]string, 0)
+	if api.Proxy == nil {
+		return tenants
+	}
+	for _, group := range api.Proxy.Groups {
+		if group == nil {
+			continue
+		}
+		for _, endpoint := range group.Endpoints {
+			if endpoint != nil {
+				tenants = append(tenants, endpoint.Tenants...)
+			}
+		}
+	}
+	slices.Sort(tenants)
+	return slices.Compact(tenants)
+}
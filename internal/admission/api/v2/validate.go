@@ -18,11 +18,15 @@ import (
 	"context"
 
 	v2 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v2"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
 
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/members"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/overlay"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/pages"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -34,16 +38,35 @@ func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionEr
 			return errs
 		}
 
+		if t, ok := obj.(*v1alpha1.ApiDefinition); ok {
+			errs.MergeWith(members.ValidatePrimaryOwner(ctx, t, t.Spec.PrimaryOwner))
+			if errs.IsSevere() {
+				return errs
+			}
+		}
+
 		if errs.IsSevere() {
 			return errs
 		}
 		if api.HasContext() {
+			errs.MergeWith(validateMembers(ctx, api))
+			if errs.IsSevere() {
+				return errs
+			}
 			errs.MergeWith(validateDryRun(ctx, api))
 		}
 	}
 	return errs
 }
 
+func validateMembers(ctx context.Context, api core.ApiDefinitionObject) *errors.AdmissionErrors {
+	impl, ok := api.GetDefinition().(*v2.Api)
+	if !ok {
+		return errors.NewAdmissionErrors()
+	}
+	return members.Validate(ctx, api, members.APIScope, members.FromAPI(impl.Members))
+}
+
 func validateDryRun(ctx context.Context, api core.ApiDefinitionObject) *errors.AdmissionErrors {
 	errs := errors.NewAdmissionErrors()
 
@@ -55,6 +78,19 @@ func validateDryRun(ctx context.Context, api core.ApiDefinitionObject) *errors.A
 		return errs
 	}
 
+	// pages sourced from services are fetched when the API is reconciled
+	pages.RemoveServiceSources(cp)
+
+	if err = pages.Resolve(ctx, cp); err != nil {
+		errs.AddSevere(err.Error())
+		return errs
+	}
+
+	if err = overlay.Apply(apimClient.Context, cp); err != nil {
+		errs.AddSevere(err.Error())
+		return errs
+	}
+
 	cp.PopulateIDs(apimClient.Context)
 
 	impl, ok := cp.GetDefinition().(*v2.Api)
@@ -62,6 +98,20 @@ func validateDryRun(ctx context.Context, api core.ApiDefinitionObject) *errors.A
 		errs.AddSevere("unable to call dry run import because api is not a v2 API")
 	}
 
+	if ok && impl.ApiBase != nil {
+		categoryErrs, existing := base.ValidateCategories(ctx, apimClient, cp.GetNamespace(), impl.Categories)
+		errs.MergeWith(categoryErrs)
+		if errs.IsSevere() {
+			return errs
+		}
+		impl.Categories = existing
+
+		errs.MergeWith(validateShardingTagsAndTenants(ctx, apimClient, cp.GetNamespace(), impl))
+		if errs.IsSevere() {
+			return errs
+		}
+	}
+
 	status, err := apimClient.APIs.DryRunImportV2(impl)
 	if err != nil {
 		errs.AddSevere(err.Error())
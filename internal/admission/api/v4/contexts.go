@@ -0,0 +1,67 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v4
+
+import (
+	"context"
+
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+)
+
+// An API is either deployed to a single context or to a list of contexts,
+// each of them being referenced once and existing in the cluster.
+func validateContexts(ctx context.Context, api *v1alpha1.ApiV4Definition) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if !api.HasContexts() {
+		return errs
+	}
+
+	if api.HasContext() {
+		errs.AddSevere("contextRef and contextRefs cannot be used together")
+		return errs
+	}
+
+	if dc := api.Spec.DefinitionContext; dc != nil && dc.SyncFrom == v4.OriginKubernetes {
+		errs.AddSevere("an API deployed to multiple management contexts must be synced from MANAGEMENT")
+	}
+
+	seen := make(map[string]bool, len(api.Spec.Contexts))
+	for i := range api.Spec.Contexts {
+		target := &api.Spec.Contexts[i]
+		ref := target.NamespacedName
+		if ref.IsMissingNamespace() {
+			ref.SetNamespace(api.Namespace)
+		}
+
+		if seen[ref.String()] {
+			errs.AddSeveref("management context [%s] is referenced more than once", ref.String())
+			continue
+		}
+		seen[ref.String()] = true
+
+		errs.Add(ctxref.ValidateRef(ctx, api.Name, &ref, api.Namespace))
+
+		for _, key := range target.DisabledPlans {
+			if _, ok := api.Spec.Plans[key]; !ok {
+				errs.AddSeveref("plan [%s] disabled in management context [%s] does not exist", key, ref.String())
+			}
+		}
+	}
+
+	return errs
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -40,7 +41,7 @@ func (a AdmissionCtrl) SetupWithManager(mgr ctrl.Manager) error {
 
 // Default implements admission.CustomDefaulter.
 func (a AdmissionCtrl) Default(ctx context.Context, obj runtime.Object) error {
-	return nil
+	return ctxref.SetDefault(ctx, obj)
 }
 
 // ValidateCreate implements admission.CustomValidator.
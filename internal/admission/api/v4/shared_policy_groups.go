@@ -0,0 +1,130 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v4
+
+import (
+	"context"
+
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/sharedpolicygroups"
+	kErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ValidateSharedPolicyGroups checks that the shared policy groups referenced by the flow steps of the API exist,
+// are synced with each management context the API is synced with, and match the type of the API and the phase
+// of the steps.
+func ValidateSharedPolicyGroups(ctx context.Context, api *v1alpha1.ApiV4Definition) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	for _, flow := range api.Spec.GetAllFlows() {
+		if flow == nil {
+			continue
+		}
+		for phase, steps := range flow.StepsByPhase() {
+			for _, step := range steps {
+				if step != nil && step.HasSharedPolicyGroupRef() {
+					errs.Add(validateSharedPolicyGroup(ctx, api, phase, step.SharedPolicyGroup))
+				}
+			}
+		}
+	}
+	return errs
+}
+
+func validateSharedPolicyGroup(
+	ctx context.Context,
+	api *v1alpha1.ApiV4Definition,
+	phase v4.FlowPhase,
+	ref *refs.NamespacedName,
+) *errors.AdmissionError {
+	if !api.HasContext() && !api.HasContexts() {
+		return errors.NewSeveref(
+			"shared policy group [%s] can only be used by an API synced with a management context", ref.Name,
+		)
+	}
+
+	group, err := sharedpolicygroups.Get(ctx, api.Namespace, ref)
+	if kErrors.IsNotFound(err) {
+		return errors.NewSeveref("shared policy group [%s] does not exist", ref.Name)
+	}
+	if err != nil {
+		return errors.NewSevere(err.Error())
+	}
+
+	if group.Spec.ApiType != api.Spec.Type {
+		return errors.NewSeveref(
+			"shared policy group [%s] is defined for %s APIs and cannot be used by a %s API",
+			ref.Name, group.Spec.ApiType, api.Spec.Type,
+		)
+	}
+
+	if group.Spec.Phase != phase {
+		return errors.NewSeveref(
+			"shared policy group [%s] is defined for the %s phase and cannot be used in the %s phase",
+			ref.Name, group.Spec.Phase, phase,
+		)
+	}
+
+	for _, target := range targetsOf(api) {
+		if !sharedpolicygroups.IsManagedWith(group, target) {
+			return errors.NewSeveref(
+				"shared policy group [%s] is not synced with management context [%s] of the API",
+				ref.Name, sharedpolicygroups.ContextKey(target.Spec.Context, target.Namespace),
+			)
+		}
+	}
+
+	return nil
+}
+
+// targetsOf returns the API as synced with each of its management contexts.
+func targetsOf(api *v1alpha1.ApiV4Definition) []*v1alpha1.ApiV4Definition {
+	if !api.HasContexts() {
+		return []*v1alpha1.ApiV4Definition{api}
+	}
+	targets := make([]*v1alpha1.ApiV4Definition, 0, len(api.Spec.Contexts))
+	for i := range api.Spec.Contexts {
+		targets = append(targets, api.ForContext(&api.Spec.Contexts[i]))
+	}
+	return targets
+}
+
+// resolveSharedPolicyGroups sets the policy of the steps referencing shared policy groups before a dry run,
+// as done when the API is reconciled. Groups that have not been synced yet are reported as warnings,
+// the API being synced once they are available, and the dry run is skipped.
+func resolveSharedPolicyGroups(ctx context.Context, api *v1alpha1.ApiV4Definition) (*errors.AdmissionErrors, bool) {
+	errs := errors.NewAdmissionErrors()
+
+	err := sharedpolicygroups.Resolve(ctx, api)
+	if err == nil {
+		return errs, true
+	}
+
+	pending, ok := sharedpolicygroups.GetPending(err)
+	if !ok {
+		errs.AddSevere(err.Error())
+		return errs, false
+	}
+
+	for _, group := range pending {
+		errs.AddWarningf(
+			"shared policy group [%s] has not been synced yet, the API will be synced once it is available", group,
+		)
+	}
+
+	return errs, false
+}
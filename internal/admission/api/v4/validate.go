@@ -18,21 +18,52 @@ import (
 	"context"
 
 	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/members"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/openapi"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/overlay"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/pages"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
 	errs := errors.NewAdmissionErrors()
+
+	obj, err := generateFromOpenAPI(ctx, obj)
+	if err != nil {
+		errs.AddSevere(err.Error())
+		return errs
+	}
+
 	if api, ok := obj.(core.ApiDefinitionObject); ok {
 		errs.MergeWith(base.ValidateCreate(ctx, obj))
 		if errs.IsSevere() {
 			return errs
 		}
 
+		if t, ok := obj.(*v1alpha1.ApiV4Definition); ok {
+			errs.Add(validateListenersAndEndpoints(t))
+			if errs.IsSevere() {
+				return errs
+			}
+			errs.MergeWith(validateContexts(ctx, t))
+			if errs.IsSevere() {
+				return errs
+			}
+			errs.MergeWith(members.ValidatePrimaryOwner(ctx, t, t.Spec.PrimaryOwner))
+			if errs.IsSevere() {
+				return errs
+			}
+			errs.MergeWith(ValidateSharedPolicyGroups(ctx, t))
+			if errs.IsSevere() {
+				return errs
+			}
+		}
+
 		if errs.IsSevere() {
 			return errs
 		}
@@ -41,12 +72,62 @@ func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionEr
 			return errs
 		}
 		if api.HasContext() {
+			errs.MergeWith(validateMembers(ctx, api))
+			if errs.IsSevere() {
+				return errs
+			}
 			errs.MergeWith(validateDryRun(ctx, api))
 		}
+
+		if t, ok := obj.(*v1alpha1.ApiV4Definition); ok && t.HasContexts() {
+			for i := range t.Spec.Contexts {
+				target := t.ForContext(&t.Spec.Contexts[i])
+				errs.MergeWith(validateMembers(ctx, target))
+				if errs.IsSevere() {
+					return errs
+				}
+				errs.MergeWith(validateDryRun(ctx, target))
+				if errs.IsSevere() {
+					return errs
+				}
+			}
+		}
 	}
 	return errs
 }
 
+// The API is validated as it will be reconciled, that is
+// once completed with what is generated from its OpenAPI specification.
+func generateFromOpenAPI(ctx context.Context, obj runtime.Object) (runtime.Object, error) {
+	api, ok := obj.(*v1alpha1.ApiV4Definition)
+	if !ok || api.Spec.OpenAPI == nil {
+		return obj, nil
+	}
+	cp := api.DeepCopy()
+	if err := openapi.Resolve(ctx, cp); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+func validateListenersAndEndpoints(api *v1alpha1.ApiV4Definition) *errors.AdmissionError {
+	if len(api.Spec.Listeners) == 0 {
+		return errors.NewSevere("at least one listener is required")
+	}
+	if len(api.Spec.EndpointGroups) == 0 {
+		return errors.NewSevere("at least one endpoint group is required")
+	}
+	return nil
+}
+
+func validateMembers(ctx context.Context, api core.ApiDefinitionObject) *errors.AdmissionErrors {
+	impl, ok := api.GetDefinition().(*v4.Api)
+	if !ok {
+		return errors.NewAdmissionErrors()
+	}
+	return members.Validate(ctx, api, members.APIScope, members.FromAPI(impl.Members))
+}
+
 func validateDryRun(ctx context.Context, api core.ApiDefinitionObject) *errors.AdmissionErrors {
 	errs := errors.NewAdmissionErrors()
 
@@ -57,6 +138,19 @@ func validateDryRun(ctx context.Context, api core.ApiDefinitionObject) *errors.A
 		errs.AddSevere(err.Error())
 	}
 
+	// pages sourced from services are fetched when the API is reconciled
+	pages.RemoveServiceSources(cp)
+
+	if err = pages.Resolve(ctx, cp); err != nil {
+		errs.AddSevere(err.Error())
+		return errs
+	}
+
+	if err = overlay.Apply(apim.Context, cp); err != nil {
+		errs.AddSevere(err.Error())
+		return errs
+	}
+
 	cp.PopulateIDs(apim.Context)
 	cp.SetDefinitionContext(v4.NewDefaultKubernetesContext().MergeWith(cp.GetDefinitionContext()))
 
@@ -65,6 +159,28 @@ func validateDryRun(ctx context.Context, api core.ApiDefinitionObject) *errors.A
 		errs.AddSevere("unable to call dry run import because api is not a v4 API")
 	}
 
+	if ok && impl.ApiBase != nil {
+		categoryErrs, existing := base.ValidateCategories(ctx, apim, cp.GetNamespace(), impl.Categories)
+		errs.MergeWith(categoryErrs)
+		if errs.IsSevere() {
+			return errs
+		}
+		impl.Categories = existing
+
+		errs.MergeWith(validateShardingTagsAndTenants(ctx, apim, cp.GetNamespace(), impl))
+		if errs.IsSevere() {
+			return errs
+		}
+	}
+
+	if t, isV4 := cp.(*v1alpha1.ApiV4Definition); isV4 {
+		groupErrs, resolved := resolveSharedPolicyGroups(ctx, t)
+		errs.MergeWith(groupErrs)
+		if !resolved {
+			return errs
+		}
+	}
+
 	status, err := apim.APIs.DryRunImportV4(impl)
 	if err != nil {
 		errs.AddSevere(err.Error())
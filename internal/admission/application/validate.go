@@ -18,7 +18,9 @@ import (
 	"context"
 
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/application"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/members"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
@@ -36,6 +38,10 @@ func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionEr
 		if errs.IsSevere() {
 			return errs
 		}
+		errs.MergeWith(validateMembers(ctx, app))
+		if errs.IsSevere() {
+			return errs
+		}
 		errs.MergeWith(validateDryRun(ctx, app))
 	}
 	return errs
@@ -65,6 +71,10 @@ func validateUpdate(
 		if errs.IsSevere() {
 			return errs
 		}
+		errs.MergeWith(validateMembers(ctx, newApp))
+		if errs.IsSevere() {
+			return errs
+		}
 		errs.MergeWith(validateDryRun(ctx, newApp))
 	}
 	return errs
@@ -102,6 +112,20 @@ func validateSettingsUpdate(oldApp, newApp core.ApplicationObject) *errors.Admis
 	return errs
 }
 
+func validateMembers(ctx context.Context, app core.ApplicationObject) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if t, ok := app.(*v1alpha1.Application); ok {
+		errs.MergeWith(members.ValidatePrimaryOwner(ctx, t, t.Spec.PrimaryOwner))
+		if errs.IsSevere() {
+			return errs
+		}
+	}
+	if impl, ok := app.GetModel().(*application.Application); ok {
+		errs.MergeWith(members.Validate(ctx, app, members.ApplicationScope, members.FromApplication(impl.Members)))
+	}
+	return errs
+}
+
 func validateDryRun(ctx context.Context, app core.ApplicationObject) *errors.AdmissionErrors {
 	errs := errors.NewAdmissionErrors()
 
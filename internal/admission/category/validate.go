@@ -0,0 +1,75 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package category
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if category, ok := obj.(*v1alpha1.Category); ok {
+		errs.Add(ctxref.Validate(ctx, category))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.Add(validateNoConflictingName(ctx, category))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.Add(validatePicture(ctx, category))
+	}
+	return errs
+}
+
+func validateNoConflictingName(ctx context.Context, category *v1alpha1.Category) *errors.AdmissionError {
+	list := &v1alpha1.CategoryList{}
+	if err := k8s.GetClient().List(ctx, list, client.InNamespace(category.Namespace)); err != nil {
+		return errors.NewSevere(err.Error())
+	}
+
+	for _, other := range list.Items {
+		if other.Name == category.Name {
+			continue
+		}
+		if other.Spec.Name == category.Spec.Name && other.Spec.Context.String() == category.Spec.Context.String() {
+			return errors.NewSeveref(
+				"category [%s] is already defined by resource [%s] for the same management context",
+				category.Spec.Name, other.Name,
+			)
+		}
+	}
+
+	return nil
+}
+
+func validatePicture(ctx context.Context, category *v1alpha1.Category) *errors.AdmissionError {
+	if category.Spec.Picture == nil {
+		return nil
+	}
+
+	if _, err := k8s.ResolveValue(ctx, category.Spec.Picture, category.Namespace); err != nil {
+		return errors.NewSeveref("unable to resolve category picture: %s", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctxref
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s/dynamic"
+	admissionV1 "k8s.io/api/admission/v1"
+	kErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetDefault references the default management context of the namespace
+// from API definitions and applications that do not reference any context.
+// The default context is either referenced by the gravitee.io/default-context annotation
+// of the namespace, or is the management context of the namespace marked as default.
+// Resources are only defaulted on creation, so that the context removed from an existing resource,
+// e.g. to stop syncing it with APIM, is not set back by the next update.
+func SetDefault(ctx context.Context, obj runtime.Object) error {
+	if req, err := admission.RequestFromContext(ctx); err == nil && req.Operation != admissionV1.Create {
+		return nil
+	}
+
+	var ref **refs.NamespacedName
+	var namespace string
+
+	switch t := obj.(type) {
+	case *v1alpha1.ApiV4Definition:
+		if t.HasContexts() {
+			return nil
+		}
+		ref, namespace = &t.Spec.Context, t.Namespace
+	case *v1alpha1.Application:
+		ref, namespace = &t.Spec.Context, t.Namespace
+	default:
+		return nil
+	}
+
+	if *ref != nil {
+		return nil
+	}
+
+	defaultRef, err := findDefault(ctx, namespace)
+	if err != nil || defaultRef == nil {
+		return err
+	}
+
+	log.FromContext(ctx).Info("Defaulting management context", "context", defaultRef.String())
+	*ref = defaultRef
+	return nil
+}
+
+func findDefault(ctx context.Context, namespace string) (*refs.NamespacedName, error) {
+	ns, err := dynamic.ResolveNamespace(ctx, namespace)
+	switch {
+	case err == nil:
+		if value := ns.Annotations[core.DefaultContextAnnotation]; value != "" {
+			return parseRef(value, namespace), nil
+		}
+	case kErrors.IsForbidden(err), kErrors.IsNotFound(err):
+		// namespaces cannot be read when the operator is scoped to a namespace
+	default:
+		return nil, err
+	}
+
+	list := &v1alpha1.ManagementContextList{}
+	if err = k8s.GetClient().List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	for _, item := range list.Items {
+		if item.Spec.Default {
+			return &refs.NamespacedName{Name: item.Name, Namespace: item.Namespace}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Annotation values are either a name, resolved in the namespace, or a namespace/name pair.
+func parseRef(value, namespace string) *refs.NamespacedName {
+	if ns, name, found := strings.Cut(value, "/"); found {
+		return &refs.NamespacedName{Name: name, Namespace: ns}
+	}
+	return &refs.NamespacedName{Name: value, Namespace: namespace}
+}
@@ -33,11 +33,32 @@ func Validate(ctx context.Context, obj runtime.Object) *errors.AdmissionError {
 }
 
 func validateContextRefExists(ctx context.Context, ctxAware core.ContextAwareObject) *errors.AdmissionError {
-	ctxRef := ctxAware.ContextRef()
-	if err := dynamic.ExpectResolvedContext(ctx, ctxRef, ctxAware.GetNamespace()); err != nil {
+	return ValidateRef(ctx, ctxAware.GetName(), ctxAware.ContextRef(), ctxAware.GetNamespace())
+}
+
+// ValidateRef checks that the context referenced by a resource exists
+// and that the namespace of the resource is allowed to reference it.
+func ValidateRef(ctx context.Context, name string, ctxRef core.ObjectRef, namespace string) *errors.AdmissionError {
+	switch ctxRef.GetKind() {
+	case "", core.CRDManagementContextKind, core.CRDClusterManagementContextKind:
+	default:
+		return errors.NewSeveref(
+			"resource [%s] references a management context of unknown kind [%s], expected one of [%s, %s]",
+			name,
+			ctxRef.GetKind(),
+			core.CRDManagementContextKind,
+			core.CRDClusterManagementContextKind,
+		)
+	}
+
+	err := dynamic.ExpectResolvedContext(ctx, ctxRef, namespace)
+	if dynamic.IsNamespaceNotAllowed(err) {
+		return errors.NewSeveref("resource [%s] cannot be created: %s", name, err.Error())
+	}
+	if err != nil {
 		return errors.NewSeveref(
 			"resource [%s] references management context [%v] that doesn't exist in the cluster",
-			ctxAware.GetName(),
+			name,
 			ctxRef,
 		)
 	}
@@ -0,0 +1,118 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dictionary
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/dictionary"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if dict, ok := obj.(*v1alpha1.Dictionary); ok {
+		errs.Add(ctxref.Validate(ctx, dict))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.MergeWith(validateType(dict))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.MergeWith(validatePropertiesSources(ctx, dict))
+	}
+	return errs
+}
+
+func validateType(dict *v1alpha1.Dictionary) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	spec := &dict.Spec
+
+	if spec.IsDynamic() {
+		if spec.Provider == nil || spec.Trigger == nil {
+			errs.AddSeveref("dynamic dictionary [%s] requires a provider and a trigger", spec.Name)
+		}
+		if len(spec.Properties) > 0 || len(spec.PropertiesFrom) > 0 {
+			errs.AddWarningf("properties of dynamic dictionary [%s] are ignored", spec.Name)
+		}
+		return errs
+	}
+
+	if spec.Provider != nil || spec.Trigger != nil {
+		errs.AddWarningf("provider and trigger of manual dictionary [%s] are ignored", spec.Name)
+	}
+
+	return errs
+}
+
+func validatePropertiesSources(ctx context.Context, dict *v1alpha1.Dictionary) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if dict.Spec.IsDynamic() {
+		return errs
+	}
+
+	for _, source := range dict.Spec.PropertiesFrom {
+		if err := validatePropertiesSource(ctx, source, dict.Namespace); err != nil {
+			errs.Add(err)
+		}
+	}
+
+	return errs
+}
+
+func validatePropertiesSource(
+	ctx context.Context,
+	source *dictionary.PropertiesSource,
+	namespace string,
+) *errors.AdmissionError {
+	switch {
+	case source.ConfigMap != nil && source.Secret != nil:
+		return errors.NewSevere("a properties source must reference either a config map or a secret, not both")
+	case source.ConfigMap != nil:
+		if err := validateSourceNamespace("config map", source.ConfigMap, namespace); err != nil {
+			return err
+		}
+		if _, err := k8s.ResolveConfigMapEntries(ctx, source.ConfigMap, namespace); err != nil {
+			return errors.NewSeveref("unable to read properties from config map [%s]: %s", source.ConfigMap, err.Error())
+		}
+	case source.Secret != nil:
+		if err := validateSourceNamespace("secret", source.Secret, namespace); err != nil {
+			return err
+		}
+		if _, err := k8s.ResolveSecretEntries(ctx, source.Secret, namespace); err != nil {
+			return errors.NewSeveref("unable to read properties from secret [%s]: %s", source.Secret, err.Error())
+		}
+	default:
+		return errors.NewSevere("a properties source must reference either a config map or a secret")
+	}
+	return nil
+}
+
+// Sources are read with the permissions of the operator, and so are restricted
+// to the namespace of the dictionary.
+func validateSourceNamespace(kind string, ref *refs.NamespacedName, namespace string) *errors.AdmissionError {
+	if ref.HasNameSpace() && ref.Namespace != namespace {
+		return errors.NewSeveref(
+			"%s [%s] must be in the namespace of the dictionary [%s]", kind, ref, namespace,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var roleScopes = map[string]bool{
+	"API":         true,
+	"APPLICATION": true,
+	"INTEGRATION": true,
+}
+
+func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if group, ok := obj.(*v1alpha1.Group); ok {
+		errs.Add(ctxref.Validate(ctx, group))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.MergeWith(validateRoleScopes(group))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.MergeWith(validateDryRun(ctx, group))
+	}
+	return errs
+}
+
+func validateRoleScopes(group *v1alpha1.Group) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+
+	for scope := range group.Spec.DefaultRoles {
+		if !roleScopes[scope] {
+			errs.AddSeveref("default role scope [%s] is invalid, expected API, APPLICATION or INTEGRATION", scope)
+		}
+	}
+
+	for _, member := range group.Spec.Members {
+		for scope := range member.Roles {
+			if !roleScopes[scope] {
+				errs.AddSeveref(
+					"role scope [%s] of member [%s] is invalid, expected API, APPLICATION or INTEGRATION",
+					scope, member.SourceID,
+				)
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateDryRun(ctx context.Context, group *v1alpha1.Group) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+
+	cp := group.DeepCopy()
+
+	apim, err := apim.FromContextRef(ctx, cp.ContextRef(), cp.GetNamespace())
+	if err != nil {
+		errs.AddSevere(err.Error())
+		return errs
+	}
+
+	cp.PopulateIDs(apim.Context)
+
+	status, err := apim.Groups.DryRunCreateOrUpdate(&cp.Spec.Type)
+	if err != nil {
+		errs.AddSevere(err.Error())
+		return errs
+	}
+	for _, severe := range status.Errors.Severe {
+		errs.AddSevere(severe)
+	}
+	if errs.IsSevere() {
+		return errs
+	}
+	for _, warning := range status.Errors.Warning {
+		errs.AddWarning(warning)
+	}
+	return errs
+}
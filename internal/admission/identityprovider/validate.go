@@ -0,0 +1,140 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityprovider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if idp, ok := obj.(*v1alpha1.IdentityProvider); ok {
+		errs.Add(ctxref.Validate(ctx, idp))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.Add(validateNoConflictingName(ctx, idp))
+		errs.MergeWith(validateConfigurationSources(ctx, idp))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.MergeWith(validateGroupMappings(ctx, idp))
+	}
+	return errs
+}
+
+func validateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) *errors.AdmissionErrors {
+	errs := validateCreate(ctx, newObj)
+	if errs.IsSevere() {
+		return errs
+	}
+
+	oldIdp, ok := oldObj.(*v1alpha1.IdentityProvider)
+	if !ok {
+		return errs
+	}
+
+	newIdp, ok := newObj.(*v1alpha1.IdentityProvider)
+	if !ok {
+		return errs
+	}
+
+	if oldIdp.Spec.Kind != newIdp.Spec.Kind {
+		errs.AddSeveref("the type of identity provider [%s] cannot be changed", newIdp.Spec.Name)
+	}
+
+	return errs
+}
+
+func validateNoConflictingName(ctx context.Context, idp *v1alpha1.IdentityProvider) *errors.AdmissionError {
+	list := &v1alpha1.IdentityProviderList{}
+	if err := k8s.GetClient().List(ctx, list, client.InNamespace(idp.Namespace)); err != nil {
+		return errors.NewSevere(err.Error())
+	}
+
+	for _, other := range list.Items {
+		if other.Name == idp.Name {
+			continue
+		}
+		if strings.EqualFold(other.Spec.Name, idp.Spec.Name) &&
+			other.Spec.Context.String() == idp.Spec.Context.String() {
+			return errors.NewSeveref(
+				"identity provider [%s] is already defined by resource [%s] for the same management context",
+				idp.Spec.Name, other.Name,
+			)
+		}
+	}
+
+	return nil
+}
+
+func validateConfigurationSources(ctx context.Context, idp *v1alpha1.IdentityProvider) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	for key, from := range idp.Spec.ConfigurationFrom {
+		if from == nil || (from.ConfigMapKeyRef == nil) == (from.SecretKeyRef == nil) {
+			errs.AddSeveref(
+				"configuration property [%s] must be read from either a config map or a secret key", key,
+			)
+			continue
+		}
+		if _, err := k8s.ResolveValue(ctx, from, idp.Namespace); err != nil {
+			errs.AddSeveref("unable to resolve configuration property [%s]: %s", key, err.Error())
+		}
+		if idp.Spec.Configuration != nil && idp.Spec.Configuration.Get(key) != nil {
+			errs.AddWarningf(
+				"configuration property [%s] is defined inline and from a source, the source value will be used", key,
+			)
+		}
+	}
+	return errs
+}
+
+// Groups of mappings are looked up in the environment of the context, a missing group failing the sync.
+func validateGroupMappings(ctx context.Context, idp *v1alpha1.IdentityProvider) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if len(idp.Spec.GroupMappings) == 0 {
+		return errs
+	}
+
+	apim, err := apim.FromContextRef(ctx, idp.Spec.Context, idp.Namespace)
+	if err != nil {
+		errs.AddWarningf("unable to validate group mappings: %s", err.Error())
+		return errs
+	}
+
+	for _, mapping := range idp.Spec.GroupMappings {
+		for _, name := range mapping.Groups {
+			group, err := apim.Env.FindGroup(name)
+			if err != nil {
+				errs.AddWarningf("unable to validate group [%s]: %s", name, err.Error())
+				return errs
+			}
+			if group == nil {
+				errs.AddSeveref("group [%s] of identity provider [%s] does not exist", name, idp.Spec.Name)
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,219 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	v2 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v2"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/certificate"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	v1 "k8s.io/api/core/v1"
+	netV1 "k8s.io/api/networking/v1"
+	kErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+
+	// only ingresses of the classes listed in INGRESS_CLASSES are validated
+	ingress, ok := obj.(*netV1.Ingress)
+	if !ok || !k8s.HasConfiguredIngressClass(ingress) {
+		return errs
+	}
+
+	errs.Add(validateRules(ingress))
+	if errs.IsSevere() {
+		return errs
+	}
+
+	errs.Add(validateTemplate(ctx, ingress))
+	if k8s.IsCanaryIngress(ingress) {
+		errs.Add(validateCanaryWeight(ingress))
+	} else {
+		errs.Add(base.ValidateNoConflictingPath(ctx, toApiDefinition(ingress)))
+	}
+	errs.MergeWith(validateTLSSecrets(ctx, ingress))
+	errs.MergeWith(validateBackendServices(ctx, ingress))
+
+	return errs
+}
+
+// Updates that do not change the spec or the template of the ingress (e.g. finalizers
+// added by the operator) are not validated so that they are never blocked by external changes.
+func validateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) *errors.AdmissionErrors {
+	oldIngress, ok := oldObj.(*netV1.Ingress)
+	if !ok {
+		return errors.NewAdmissionErrors()
+	}
+
+	newIngress, ok := newObj.(*netV1.Ingress)
+	if !ok || !newIngress.DeletionTimestamp.IsZero() {
+		return errors.NewAdmissionErrors()
+	}
+
+	if reflect.DeepEqual(oldIngress.Spec, newIngress.Spec) &&
+		getTemplate(oldIngress) == getTemplate(newIngress) &&
+		getCanary(oldIngress) == getCanary(newIngress) {
+		return errors.NewAdmissionErrors()
+	}
+
+	return validateCreate(ctx, newIngress)
+}
+
+func getTemplate(ingress *netV1.Ingress) string {
+	return ingress.GetAnnotations()[core.IngressTemplateAnnotation]
+}
+
+func getCanary(ingress *netV1.Ingress) string {
+	annotations := ingress.GetAnnotations()
+	return annotations[core.IngressCanaryAnnotation] + "/" + annotations[core.IngressCanaryWeightAnnotation]
+}
+
+func validateRules(ingress *netV1.Ingress) *errors.AdmissionError {
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			return errors.NewSeveref("rule for host [%s] must define HTTP paths", rule.Host)
+		}
+	}
+	return nil
+}
+
+// Canary ingresses share the API of the ingresses exposing the same hosts and paths,
+// so they are not checked for conflicting paths. A canary without weight receives no traffic.
+func validateCanaryWeight(ingress *netV1.Ingress) *errors.AdmissionError {
+	if _, ok := ingress.GetAnnotations()[core.IngressCanaryWeightAnnotation]; !ok {
+		return errors.NewWarningf(
+			"canary ingress [%s] has no [%s] annotation and will not receive any traffic",
+			ingress.Name, core.IngressCanaryWeightAnnotation,
+		)
+	}
+	if _, err := k8s.GetCanaryWeight(ingress); err != nil {
+		return errors.NewSevere(err.Error())
+	}
+	return nil
+}
+
+func validateTemplate(ctx context.Context, ingress *netV1.Ingress) *errors.AdmissionError {
+	name := getTemplate(ingress)
+	if name == "" {
+		return nil
+	}
+
+	template := &v1alpha1.ApiDefinition{}
+	key := types.NamespacedName{Namespace: ingress.Namespace, Name: name}
+	if err := k8s.GetClient().Get(ctx, key, template); err != nil {
+		if kErrors.IsNotFound(err) {
+			return errors.NewSeveref("API definition template [%s] doesn't exist in the cluster", key)
+		}
+		return errors.NewSevere(err.Error())
+	}
+
+	return nil
+}
+
+func validateTLSSecrets(ctx context.Context, ingress *netV1.Ingress) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+
+	for _, tls := range ingress.Spec.TLS {
+		secret := &v1.Secret{}
+		key := types.NamespacedName{Namespace: ingress.Namespace, Name: tls.SecretName}
+		if err := k8s.GetClient().Get(ctx, key, secret); err != nil {
+			if kErrors.IsNotFound(err) {
+				errs.AddSeveref("TLS secret [%s] doesn't exist in the cluster", key)
+			} else {
+				errs.AddSevere(err.Error())
+			}
+			continue
+		}
+
+		crt, pk := secret.Data[v1.TLSCertKey], secret.Data[v1.TLSPrivateKeyKey]
+		if err := certificate.ValidateKeyPair(crt, pk, time.Now()); err != nil {
+			errs.AddSeveref("TLS secret [%s]: %s", key, err.Error())
+		}
+	}
+
+	return errs
+}
+
+func validateBackendServices(ctx context.Context, ingress *netV1.Ingress) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+
+	for _, name := range getBackendServices(ingress) {
+		service := &v1.Service{}
+		key := types.NamespacedName{Namespace: ingress.Namespace, Name: name}
+		if err := k8s.GetClient().Get(ctx, key, service); err != nil {
+			if kErrors.IsNotFound(err) {
+				errs.AddSeveref("backend service [%s] doesn't exist in the cluster", key)
+			} else {
+				errs.AddSevere(err.Error())
+			}
+		}
+	}
+
+	return errs
+}
+
+func getBackendServices(ingress *netV1.Ingress) []string {
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+
+	add := func(backend *netV1.IngressBackend) {
+		if backend == nil || backend.Service == nil || seen[backend.Service.Name] {
+			return
+		}
+		seen[backend.Service.Name] = true
+		names = append(names, backend.Service.Name)
+	}
+
+	add(ingress.Spec.DefaultBackend)
+	for _, rule := range ingress.Spec.Rules {
+		for i := range rule.HTTP.Paths {
+			add(&rule.HTTP.Paths[i].Backend)
+		}
+	}
+
+	return names
+}
+
+// The API generated for an ingress has the name and namespace of the ingress,
+// with one virtual host per host and path, which is all we need to check for conflicts.
+func toApiDefinition(ingress *netV1.Ingress) *v1alpha1.ApiDefinition {
+	vhs := make([]*v2.VirtualHost, 0)
+	for _, rule := range ingress.Spec.Rules {
+		for _, path := range rule.HTTP.Paths {
+			vhs = append(vhs, &v2.VirtualHost{Host: rule.Host, Path: path.Path})
+		}
+	}
+
+	api := &v1alpha1.ApiDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ingress.Name,
+			Namespace: ingress.Namespace,
+		},
+	}
+	api.Spec.Proxy = &v2.Proxy{VirtualHosts: vhs}
+
+	return api
+}
@@ -26,6 +26,8 @@ import (
 
 var _ admission.CustomValidator = AdmissionCtrl{}
 var _ admission.CustomDefaulter = AdmissionCtrl{}
+var _ admission.CustomValidator = ClusterAdmissionCtrl{}
+var _ admission.CustomDefaulter = ClusterAdmissionCtrl{}
 
 func (a AdmissionCtrl) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
@@ -63,5 +65,19 @@ func (a AdmissionCtrl) ValidateUpdate(
 	oldObj runtime.Object,
 	newObj runtime.Object,
 ) (admission.Warnings, error) {
-	return admission.Warnings{}, nil
+	return validateUpdate(ctx, oldObj, newObj).Map()
+}
+
+// ClusterAdmissionCtrl validates and defaults cluster management contexts
+// the same way as management contexts.
+type ClusterAdmissionCtrl struct {
+	AdmissionCtrl
+}
+
+func (a ClusterAdmissionCtrl) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha1.ClusterManagementContext{}).
+		WithValidator(a).
+		WithDefaulter(a).
+		Complete()
 }
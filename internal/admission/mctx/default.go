@@ -43,7 +43,7 @@ func defaultContextUsingCloudToken(ctx context.Context, contextObject core.Conte
 	var cloudToken string
 
 	if contextObject.GetCloud().HasSecretRef() {
-		secret, err := dynamic.ResolveSecret(ctx, contextObject.GetCloud().GetSecretRef(), contextObject.GetNamespace())
+		secret, err := dynamic.ResolveSecret(ctx, contextObject.GetCloud().GetSecretRef(), secretNamespace(contextObject))
 		if err != nil {
 			return gioerr.NewSeveref("secret [%v] doesn't exist in the cluster", contextObject.GetCloud().GetSecretRef())
 		}
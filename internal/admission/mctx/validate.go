@@ -19,11 +19,18 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s/dynamic"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/overlay"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
@@ -37,9 +44,26 @@ func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionEr
 		errs.Add(validateContextIsAvailable(ctx, context))
 	}
 
+	switch t := obj.(type) {
+	case *v1alpha1.ManagementContext:
+		errs.MergeWith(validateApiPatches(t.Spec.Context))
+		errs.Add(validateSingleDefault(ctx, t))
+		errs.Add(validateNamespaceSelector(t.Spec.Context))
+	case *v1alpha1.ClusterManagementContext:
+		errs.MergeWith(validateApiPatches(t.Spec.Context))
+		errs.Add(validateNamespaceSelector(t.Spec.Context))
+		errs.Add(validateClusterSecretRef(t))
+	}
+
 	return errs
 }
 
+// An updated context is validated as a new one, e.g. so that API patches are never stored
+// in a form that would fail the sync of every API of the context.
+func validateUpdate(ctx context.Context, _ runtime.Object, newObj runtime.Object) *errors.AdmissionErrors {
+	return validateCreate(ctx, newObj)
+}
+
 func validateRequiredField(context core.ContextObject) *errors.AdmissionError {
 	err := checkEmpty(context.GetURL(), "[baseUrl]")
 	if err != nil {
@@ -75,7 +99,7 @@ func checkEmpty(s string, field string) *errors.AdmissionError {
 
 func validateSecretRef(ctx context.Context, context core.ContextObject) *errors.AdmissionError {
 	if context.HasSecretRef() {
-		if err := dynamic.ExpectResolvedSecret(ctx, context.GetSecretRef(), context.GetNamespace()); err != nil {
+		if err := dynamic.ExpectResolvedSecret(ctx, context.GetSecretRef(), secretNamespace(context)); err != nil {
 			return errors.NewSeveref(
 				"secret [%v] doesn't exist in the cluster",
 				context.GetSecretRef(),
@@ -85,6 +109,29 @@ func validateSecretRef(ctx context.Context, context core.ContextObject) *errors.
 	return nil
 }
 
+// Secrets of cluster management contexts are kept in the namespace of the operator
+// so that credentials are never exposed to the namespaces referencing the context.
+func validateClusterSecretRef(context *v1alpha1.ClusterManagementContext) *errors.AdmissionError {
+	if !context.HasSecretRef() {
+		return nil
+	}
+	if ns := context.GetSecretRef().GetNamespace(); ns != "" && ns != env.Config.OperatorNS {
+		return errors.NewSeveref(
+			"secret [%v] must be defined in the namespace of the operator [%s]",
+			context.GetSecretRef(),
+			env.Config.OperatorNS,
+		)
+	}
+	return nil
+}
+
+func secretNamespace(context core.ContextObject) string {
+	if _, ok := context.(*v1alpha1.ClusterManagementContext); ok {
+		return env.Config.OperatorNS
+	}
+	return context.GetNamespace()
+}
+
 func validateContextIsAvailable(ctx context.Context, context core.ContextObject) *errors.AdmissionError {
 	apim, err := apim.FromContext(ctx, context, context.GetNamespace())
 	if err != nil {
@@ -112,3 +159,49 @@ func validateContextIsAvailable(ctx context.Context, context core.ContextObject)
 
 	return nil
 }
+
+func validateApiPatches(context *management.Context) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if context == nil {
+		return errs
+	}
+	for i := range context.ApiPatches {
+		if err := overlay.Validate(&context.ApiPatches[i]); err != nil {
+			errs.AddSeveref("API patch #%d cannot be decoded: %s", i, err.Error())
+		}
+	}
+	return errs
+}
+
+// A single context of a namespace can be used as the default context of the namespace.
+func validateSingleDefault(ctx context.Context, context *v1alpha1.ManagementContext) *errors.AdmissionError {
+	if !context.Spec.Default {
+		return nil
+	}
+
+	list := &v1alpha1.ManagementContextList{}
+	if err := k8s.GetClient().List(ctx, list, client.InNamespace(context.Namespace)); err != nil {
+		return errors.NewSevere(err.Error())
+	}
+
+	for _, other := range list.Items {
+		if other.Name != context.Name && other.Spec.Default {
+			return errors.NewSeveref(
+				"management context [%s] is already the default context of namespace [%s]",
+				other.Name, context.Namespace,
+			)
+		}
+	}
+
+	return nil
+}
+
+func validateNamespaceSelector(context *management.Context) *errors.AdmissionError {
+	if context == nil || context.NamespaceSelector == nil {
+		return nil
+	}
+	if _, err := metav1.LabelSelectorAsSelector(context.NamespaceSelector); err != nil {
+		return errors.NewSeveref("[namespaceSelector] is invalid: %s", err.Error())
+	}
+	return nil
+}
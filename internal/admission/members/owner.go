@@ -0,0 +1,61 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package members
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/owner"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+)
+
+// ValidatePrimaryOwner checks that the user or group referenced as primary owner
+// exists in the APIM instance the context of the given object points to.
+func ValidatePrimaryOwner(
+	ctx context.Context,
+	obj core.ContextAwareObject,
+	po *owner.PrimaryOwner,
+) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+
+	if po == nil {
+		return errs
+	}
+
+	if !obj.HasContext() {
+		errs.AddWarning("primary owner is ignored because no management context is defined")
+		return errs
+	}
+
+	apim, err := apim.FromContextRef(ctx, obj.ContextRef(), obj.GetNamespace())
+	if err != nil {
+		errs.AddSevere(err.Error())
+		return errs
+	}
+
+	ownerID, err := apim.ResolvePrimaryOwner(po)
+	if err != nil {
+		errs.AddWarningf("unable to validate primary owner [%s]: %s", po, err.Error())
+		return errs
+	}
+
+	if ownerID == "" {
+		errs.AddSeveref("primary owner [%s] does not exist", po)
+	}
+
+	return errs
+}
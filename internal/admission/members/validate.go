@@ -0,0 +1,214 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package members
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/application"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	APIScope         = "API"
+	ApplicationScope = "APPLICATION"
+
+	defaultRole = "USER"
+)
+
+// Member is a user of an APIM organization granted a role on an API or an application.
+type Member struct {
+	Source   string
+	SourceID string
+	Role     string
+}
+
+// FromAPI returns the members of an API definition.
+func FromAPI(members []*base.Member) []Member {
+	result := make([]Member, 0, len(members))
+	for _, member := range members {
+		result = append(result, Member{Source: member.Source, SourceID: member.SourceID, Role: member.Role})
+	}
+	return result
+}
+
+// FromApplication returns the members of an application.
+func FromApplication(members *[]application.Member) []Member {
+	if members == nil {
+		return nil
+	}
+	result := make([]Member, 0, len(*members))
+	for _, member := range *members {
+		result = append(result, Member{Source: member.Source, SourceID: member.SourceID, Role: member.Role})
+	}
+	return result
+}
+
+// Validate resolves the members of the given object against the users and roles
+// of the organization its context points to. Depending on the configured validation mode,
+// members that cannot be resolved are either reported as warnings or rejected.
+func Validate(
+	ctx context.Context,
+	obj core.ContextAwareObject,
+	scope string,
+	members []Member,
+) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+
+	if env.Config.MembersValidation == env.MembersValidationOff || len(members) == 0 || !obj.HasContext() {
+		return errs
+	}
+
+	apim, err := apim.FromContextRef(ctx, obj.ContextRef(), obj.GetNamespace())
+	if err != nil {
+		errs.AddSevere(err.Error())
+		return errs
+	}
+
+	roles, err := apim.Org.GetRoles(scope)
+	if err != nil {
+		errs.AddWarningf("unable to validate member roles: %s", err.Error())
+	}
+
+	for _, member := range members {
+		if roles != nil && !hasRole(roles, member.Role) {
+			validateRoleResource(ctx, obj, errs, scope, member)
+		}
+
+		user, findErr := apim.Org.FindUser(member.Source, member.SourceID)
+		if findErr != nil {
+			errs.AddWarningf("unable to validate member [%s]: %s", member.SourceID, findErr.Error())
+			continue
+		}
+
+		if user != nil {
+			continue
+		}
+
+		if env.IsProvisioningSource(member.Source) {
+			errs.AddWarningf(
+				"member [%s] does not exist in source [%s] and will be provisioned",
+				member.SourceID, member.Source,
+			)
+		} else {
+			add(errs, "member [%s] does not exist in source [%s]", member.SourceID, member.Source)
+		}
+	}
+
+	return errs
+}
+
+// Roles that are not known by APIM yet may be defined by a Role resource of the same context
+// that has not been synced yet, in which case the member is only reported as a warning.
+func validateRoleResource(
+	ctx context.Context,
+	obj core.ContextAwareObject,
+	errs *errors.AdmissionErrors,
+	scope string,
+	member Member,
+) {
+	resource, err := findRoleResource(ctx, obj, scope, member.Role)
+	if err != nil {
+		errs.AddWarningf("unable to validate role [%s] of member [%s]: %s", member.Role, member.SourceID, err.Error())
+		return
+	}
+
+	switch {
+	case resource == nil:
+		add(errs, "role [%s] of member [%s] does not exist in scope [%s]", member.Role, member.SourceID, scope)
+	case string(resource.Spec.Scope) != scope:
+		add(
+			errs, "role [%s] of member [%s] is defined by resource [%s] with scope [%s] instead of [%s]",
+			member.Role, member.SourceID, resource.Name, resource.Spec.Scope, scope,
+		)
+	default:
+		errs.AddWarningf(
+			"role [%s] of member [%s] is defined by resource [%s] but has not been synced with APIM yet",
+			member.Role, member.SourceID, resource.Name,
+		)
+	}
+}
+
+// findRoleResource returns the Role resource defining the given role for the context of the given object,
+// preferring a resource of the expected scope over one of another scope.
+func findRoleResource(
+	ctx context.Context,
+	obj core.ContextAwareObject,
+	scope, name string,
+) (*v1alpha1.Role, error) {
+	if name == "" {
+		name = defaultRole
+	}
+
+	list := &v1alpha1.RoleList{}
+	if err := k8s.GetClient().List(ctx, list, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil, err
+	}
+
+	context := obj.ContextRef().NamespacedName()
+	if context.Namespace == "" {
+		context.Namespace = obj.GetNamespace()
+	}
+
+	var found *v1alpha1.Role
+	for i := range list.Items {
+		role := &list.Items[i]
+		if !strings.EqualFold(role.Spec.Name, name) || role.Spec.Context == nil {
+			continue
+		}
+		roleContext := role.Spec.Context.NamespacedName()
+		if roleContext.Namespace == "" {
+			roleContext.Namespace = role.Namespace
+		}
+		if roleContext != context {
+			continue
+		}
+		if string(role.Spec.Scope) == scope {
+			return role, nil
+		}
+		found = role
+	}
+
+	return found, nil
+}
+
+func hasRole(roles []model.Role, name string) bool {
+	if name == "" {
+		name = defaultRole
+	}
+	for _, role := range roles {
+		if strings.EqualFold(role.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func add(errs *errors.AdmissionErrors, format string, args ...any) {
+	if env.Config.MembersValidation == env.MembersValidationReject {
+		errs.AddSeveref(format, args...)
+	} else {
+		errs.AddWarningf(format, args...)
+	}
+}
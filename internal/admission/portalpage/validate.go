@@ -0,0 +1,234 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package portalpage
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/portalpage"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	apiBase "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if page, ok := obj.(*v1alpha1.PortalPage); ok {
+		errs.Add(ctxref.Validate(ctx, page))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.MergeWith(validateKind(page))
+		errs.Add(validateParent(ctx, page))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.Add(validateSiblings(ctx, page))
+		errs.MergeWith(validateContent(ctx, page))
+		errs.MergeWith(validateAccessControls(ctx, page))
+	}
+	return errs
+}
+
+func validateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	oldPage, ok := oldObj.(*v1alpha1.PortalPage)
+	if !ok {
+		return errs
+	}
+	newPage, ok := newObj.(*v1alpha1.PortalPage)
+	if !ok {
+		return errs
+	}
+
+	if oldPage.Spec.Kind != newPage.Spec.Kind {
+		errs.AddSeveref(
+			"the type of portal page [%s] cannot be changed from [%s] to [%s]",
+			newPage.Spec.Name, oldPage.Spec.Kind, newPage.Spec.Kind,
+		)
+		return errs
+	}
+
+	errs.MergeWith(validateCreate(ctx, newPage))
+	return errs
+}
+
+// Folders only hold other pages, whereas links must point somewhere.
+func validateKind(page *v1alpha1.PortalPage) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	spec := &page.Spec
+
+	switch spec.Kind {
+	case base.FolderPageType:
+		if spec.Content != "" || spec.ContentFrom != nil || spec.Source != nil {
+			errs.AddSeveref("folder [%s] cannot define a content, a content reference or a source", spec.Name)
+		}
+		if spec.HomePage {
+			errs.AddSeveref("folder [%s] cannot be used as the portal homepage", spec.Name)
+		}
+	case portalpage.LinkPageType:
+		if spec.Content == "" && spec.ContentFrom == nil {
+			errs.AddSeveref("link [%s] must define the resource it points to as its content", spec.Name)
+		}
+	}
+
+	if spec.Source != nil && spec.ContentFrom != nil {
+		errs.AddSeveref("page [%s] cannot define both a source and a content reference", spec.Name)
+	}
+
+	return errs
+}
+
+// The parent must be a folder managed with the same context, and must not
+// be the page itself or one of its descendants.
+func validateParent(ctx context.Context, page *v1alpha1.PortalPage) *errors.AdmissionError {
+	ref := page.GetParentRef()
+	if ref == nil {
+		return nil
+	}
+
+	visited := map[string]bool{page.Namespace + "/" + page.Name: true}
+	for ref != nil {
+		if visited[ref.String()] {
+			return errors.NewSeveref("parent folder [%s] of portal page [%s] introduces a cycle", ref, page.Spec.Name)
+		}
+		visited[ref.String()] = true
+
+		parent := new(v1alpha1.PortalPage)
+		if err := k8s.GetClient().Get(ctx, ref.NamespacedName(), parent); err != nil {
+			return errors.NewSeveref("unable to resolve parent folder [%s] of portal page [%s]: %s", ref, page.Spec.Name, err)
+		}
+
+		if parent.Spec.Kind != base.FolderPageType {
+			return errors.NewSeveref("parent [%s] of portal page [%s] is not a folder", ref, page.Spec.Name)
+		}
+
+		if !sameContext(page, parent) {
+			return errors.NewSeveref(
+				"parent folder [%s] of portal page [%s] is not managed by the same management context",
+				ref, page.Spec.Name,
+			)
+		}
+
+		ref = parent.GetParentRef()
+	}
+
+	return nil
+}
+
+// APIM identifies pages by name under their parent, so two resources cannot
+// define pages with the same name in the same folder. The portal also has a single homepage.
+func validateSiblings(ctx context.Context, page *v1alpha1.PortalPage) *errors.AdmissionError {
+	list := &v1alpha1.PortalPageList{}
+	if err := k8s.GetClient().List(ctx, list); err != nil {
+		return errors.NewSevere(err.Error())
+	}
+
+	for i := range list.Items {
+		other := &list.Items[i]
+		if other.Namespace == page.Namespace && other.Name == page.Name {
+			continue
+		}
+		if !sameContext(page, other) {
+			continue
+		}
+		if other.Spec.Name == page.Spec.Name && sameParent(page, other) {
+			return errors.NewSeveref(
+				"portal page [%s] is already defined by resource [%s/%s] in the same folder",
+				page.Spec.Name, other.Namespace, other.Name,
+			)
+		}
+		if page.Spec.HomePage && other.Spec.HomePage {
+			return errors.NewSeveref(
+				"the portal homepage of management context [%s] is already defined by resource [%s/%s]",
+				page.Spec.Context, other.Namespace, other.Name,
+			)
+		}
+	}
+
+	return nil
+}
+
+func validateContent(ctx context.Context, page *v1alpha1.PortalPage) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	content := page.Spec.Content
+
+	if page.Spec.ContentFrom != nil {
+		value, err := k8s.ResolveValue(ctx, page.Spec.ContentFrom, page.Namespace)
+		if err != nil {
+			errs.AddSeveref("unable to resolve content of portal page [%s]: %s", page.Spec.Name, err.Error())
+			return errs
+		}
+		content = string(value)
+	}
+
+	errs.MergeWith(apiBase.ValidatePageContent(ctx, page.Spec.Name, page.Spec.Kind, content, nil))
+	return errs
+}
+
+// Groups are looked up in the environment of the context, a missing group failing the sync.
+func validateAccessControls(ctx context.Context, page *v1alpha1.PortalPage) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if len(page.Spec.AccessControls) == 0 {
+		return errs
+	}
+
+	apim, err := apim.FromContextRef(ctx, page.Spec.Context, page.Namespace)
+	if err != nil {
+		errs.AddWarningf("unable to validate access controls: %s", err.Error())
+		return errs
+	}
+
+	for _, ac := range page.Spec.AccessControls {
+		group, err := apim.Env.FindGroup(ac.ReferenceID)
+		if err != nil {
+			errs.AddWarningf("unable to validate access control group [%s]: %s", ac.ReferenceID, err.Error())
+			return errs
+		}
+		if group == nil {
+			errs.AddSeveref("access control group [%s] of portal page [%s] does not exist", ac.ReferenceID, page.Spec.Name)
+		}
+	}
+
+	return errs
+}
+
+func sameContext(page, other *v1alpha1.PortalPage) bool {
+	return contextOf(page) == contextOf(other)
+}
+
+func contextOf(page *v1alpha1.PortalPage) string {
+	ref := page.Spec.Context
+	if ref == nil {
+		return ""
+	}
+	if ref.IsMissingNamespace() {
+		return page.Namespace + "/" + ref.Name
+	}
+	return ref.String()
+}
+
+func sameParent(page, other *v1alpha1.PortalPage) bool {
+	pageParent, otherParent := page.GetParentRef(), other.GetParentRef()
+	if pageParent == nil || otherParent == nil {
+		return pageParent == otherParent
+	}
+	return pageParent.String() == otherParent.String()
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package portaltheme
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if theme, ok := obj.(*v1alpha1.PortalTheme); ok {
+		errs.Add(ctxref.Validate(ctx, theme))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.Add(validateSingleThemePerContext(ctx, theme))
+		errs.MergeWith(validateSources(ctx, theme))
+		errs.MergeWith(validateLinks(theme))
+	}
+	return errs
+}
+
+// The theme of a portal being unique, two resources cannot manage the theme of the same environment.
+func validateSingleThemePerContext(ctx context.Context, theme *v1alpha1.PortalTheme) *errors.AdmissionError {
+	list := &v1alpha1.PortalThemeList{}
+	if err := k8s.GetClient().List(ctx, list, client.InNamespace(theme.Namespace)); err != nil {
+		return errors.NewSevere(err.Error())
+	}
+
+	for _, other := range list.Items {
+		if other.Name == theme.Name {
+			continue
+		}
+		if other.Spec.Context.String() == theme.Spec.Context.String() {
+			return errors.NewSeveref(
+				"the portal theme of management context [%s] is already defined by resource [%s]",
+				theme.Spec.Context, other.Name,
+			)
+		}
+	}
+
+	return nil
+}
+
+func validateSources(ctx context.Context, theme *v1alpha1.PortalTheme) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	for _, from := range theme.Spec.Sources() {
+		if (from.ConfigMapKeyRef == nil) == (from.SecretKeyRef == nil) {
+			errs.AddSevere("portal theme values must be read from either a config map or a secret key")
+			continue
+		}
+		if _, err := k8s.ResolveValue(ctx, from, theme.Namespace); err != nil {
+			errs.AddSeveref("unable to resolve portal theme value: %s", err.Error())
+		}
+	}
+	return errs
+}
+
+func validateLinks(theme *v1alpha1.PortalTheme) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	names := make(map[string]bool, len(theme.Spec.Navigation))
+	for _, link := range theme.Spec.Navigation {
+		if names[link.Name] {
+			errs.AddSeveref("navigation link [%s] is defined more than once", link.Name)
+		}
+		names[link.Name] = true
+
+		if target, err := url.Parse(link.Target); err != nil || !target.IsAbs() {
+			errs.AddSeveref("target [%s] of navigation link [%s] must be an absolute URL", link.Target, link.Name)
+		}
+	}
+	return errs
+}
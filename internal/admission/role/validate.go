@@ -0,0 +1,112 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package role
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if role, ok := obj.(*v1alpha1.Role); ok {
+		errs.Add(ctxref.Validate(ctx, role))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.Add(validateNoConflictingName(ctx, role))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.Add(validateNotSystemRole(ctx, role))
+	}
+	return errs
+}
+
+func validateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) *errors.AdmissionErrors {
+	errs := validateCreate(ctx, newObj)
+	if errs.IsSevere() {
+		return errs
+	}
+
+	oldRole, ok := oldObj.(*v1alpha1.Role)
+	if !ok {
+		return errs
+	}
+
+	newRole, ok := newObj.(*v1alpha1.Role)
+	if !ok {
+		return errs
+	}
+
+	if !strings.EqualFold(oldRole.Spec.Name, newRole.Spec.Name) || oldRole.Spec.Scope != newRole.Spec.Scope {
+		errs.AddSeveref(
+			"the name and scope of role [%s] cannot be changed", oldRole.Spec.Name,
+		)
+	}
+
+	return errs
+}
+
+func validateNoConflictingName(ctx context.Context, role *v1alpha1.Role) *errors.AdmissionError {
+	list := &v1alpha1.RoleList{}
+	if err := k8s.GetClient().List(ctx, list, client.InNamespace(role.Namespace)); err != nil {
+		return errors.NewSevere(err.Error())
+	}
+
+	for _, other := range list.Items {
+		if other.Name == role.Name || other.Spec.Scope != role.Spec.Scope {
+			continue
+		}
+		if strings.EqualFold(other.Spec.Name, role.Spec.Name) &&
+			other.Spec.Context.String() == role.Spec.Context.String() {
+			return errors.NewSeveref(
+				"role [%s] with scope [%s] is already defined by resource [%s] for the same management context",
+				role.Spec.Name, role.Spec.Scope, other.Name,
+			)
+		}
+	}
+
+	return nil
+}
+
+// System roles (e.g. ADMIN or PRIMARY_OWNER) are managed by APIM and cannot be overridden.
+func validateNotSystemRole(ctx context.Context, role *v1alpha1.Role) *errors.AdmissionError {
+	apim, err := apim.FromContextRef(ctx, role.Spec.Context, role.Namespace)
+	if err != nil {
+		return errors.NewWarningf("unable to check for system roles: %s", err.Error())
+	}
+
+	existing, err := apim.Roles.Find(string(role.Spec.Scope), role.Spec.Name)
+	if err != nil {
+		return errors.NewWarningf("unable to check for system roles: %s", err.Error())
+	}
+
+	if existing != nil && existing.System {
+		return errors.NewSeveref(
+			"role [%s] is a system role of scope [%s] and cannot be managed", role.Spec.Name, role.Spec.Scope,
+		)
+	}
+
+	return nil
+}
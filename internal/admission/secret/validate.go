@@ -0,0 +1,120 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/certificate"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/search"
+	v1 "k8s.io/api/core/v1"
+	netV1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	util "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+
+	if secret, ok := obj.(*v1.Secret); ok && secret.Type == v1.SecretTypeTLS && isUsedByGravitee(ctx, secret) {
+		errs.MergeWith(validateKeyPair(secret))
+	}
+
+	return errs
+}
+
+// Updates that do not change the data of the secret (e.g. finalizers or annotations
+// added by the operator) are not validated so that they never get blocked by an expiry.
+func validateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) *errors.AdmissionErrors {
+	oldSecret, ok := oldObj.(*v1.Secret)
+	if !ok {
+		return errors.NewAdmissionErrors()
+	}
+
+	newSecret, ok := newObj.(*v1.Secret)
+	if !ok {
+		return errors.NewAdmissionErrors()
+	}
+
+	if !newSecret.DeletionTimestamp.IsZero() || reflect.DeepEqual(oldSecret.Data, newSecret.Data) {
+		return errors.NewAdmissionErrors()
+	}
+
+	return validateCreate(ctx, newSecret)
+}
+
+func validateKeyPair(secret *v1.Secret) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	now := time.Now()
+
+	crt, key := secret.Data[v1.TLSCertKey], secret.Data[v1.TLSPrivateKeyKey]
+	if err := certificate.ValidateKeyPair(crt, key, now); err != nil {
+		errs.AddSeveref("secret [%s/%s]: %s", secret.Namespace, secret.Name, err.Error())
+		return errs
+	}
+
+	thresholds := env.Config.CertificateExpiryThresholds
+	if len(thresholds) == 0 {
+		return errs
+	}
+
+	for _, info := range certificate.Find(secret) {
+		if info.ExpiresIn(now) <= thresholds[len(thresholds)-1] {
+			errs.AddWarningf("%s expires on %s", info, info.NotAfter.Format(time.RFC3339))
+		}
+	}
+
+	return errs
+}
+
+// The webhook only receives secrets labelled for certificate validation, either by the operator
+// when it starts relying on them or by users who want their key pairs validated before use.
+func isUsedByGravitee(ctx context.Context, secret *v1.Secret) bool {
+	if secret.Labels[core.CertificateValidationLabel] == env.TrueString {
+		return true
+	}
+
+	if util.ContainsFinalizer(secret, core.KeyPairFinalizer) || util.ContainsFinalizer(secret, core.TemplatingFinalizer) {
+		return true
+	}
+
+	ingresses := &netV1.IngressList{}
+	if err := search.FindByFieldReferencing(
+		ctx,
+		indexer.TLSSecretField,
+		refs.NewNamespacedName(secret.Namespace, secret.Name),
+		ingresses,
+	); err != nil {
+		log.FromContext(ctx).Error(err, "unable to look up ingresses referencing secret")
+		return false
+	}
+
+	for i := range ingresses.Items {
+		if k8s.IsGraviteeIngress(ctx, &ingresses.Items[i]) {
+			return true
+		}
+	}
+
+	return false
+}
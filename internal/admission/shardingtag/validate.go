@@ -0,0 +1,91 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shardingtag
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if tag, ok := obj.(*v1alpha1.ShardingTag); ok {
+		errs.Add(ctxref.Validate(ctx, tag))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.Add(validateNoConflictingName(ctx, tag))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.MergeWith(validateRestrictedGroups(ctx, tag))
+	}
+	return errs
+}
+
+func validateNoConflictingName(ctx context.Context, tag *v1alpha1.ShardingTag) *errors.AdmissionError {
+	list := &v1alpha1.ShardingTagList{}
+	if err := k8s.GetClient().List(ctx, list, client.InNamespace(tag.Namespace)); err != nil {
+		return errors.NewSevere(err.Error())
+	}
+
+	for _, other := range list.Items {
+		if other.Name == tag.Name {
+			continue
+		}
+		if other.Spec.Name == tag.Spec.Name && other.Spec.Context.String() == tag.Spec.Context.String() {
+			return errors.NewSeveref(
+				"sharding tag [%s] is already defined by resource [%s] for the same management context",
+				tag.Spec.Name, other.Name,
+			)
+		}
+	}
+
+	return nil
+}
+
+// Restricted groups are looked up in the environment of the context, a missing group failing the sync.
+func validateRestrictedGroups(ctx context.Context, tag *v1alpha1.ShardingTag) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if len(tag.Spec.RestrictedGroups) == 0 {
+		return errs
+	}
+
+	apim, err := apim.FromContextRef(ctx, tag.Spec.Context, tag.Namespace)
+	if err != nil {
+		errs.AddWarningf("unable to validate restricted groups: %s", err.Error())
+		return errs
+	}
+
+	for _, name := range tag.Spec.RestrictedGroups {
+		group, err := apim.Env.FindGroup(name)
+		if err != nil {
+			errs.AddWarningf("unable to validate restricted group [%s]: %s", name, err.Error())
+			return errs
+		}
+		if group == nil {
+			errs.AddSeveref("restricted group [%s] of sharding tag [%s] does not exist", name, tag.Spec.Name)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharedpolicygroup
+
+import (
+	"context"
+
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if group, ok := obj.(*v1alpha1.SharedPolicyGroup); ok {
+		errs.Add(ctxref.Validate(ctx, group))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.Add(validatePhase(group))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.MergeWith(validateSteps(group))
+	}
+	return errs
+}
+
+func validateUpdate(ctx context.Context, oldObj runtime.Object, newObj runtime.Object) *errors.AdmissionErrors {
+	errs := validateCreate(ctx, newObj)
+	if errs.IsSevere() {
+		return errs
+	}
+
+	oldGroup, ok := oldObj.(*v1alpha1.SharedPolicyGroup)
+	if !ok {
+		return errs
+	}
+
+	newGroup, ok := newObj.(*v1alpha1.SharedPolicyGroup)
+	if !ok {
+		return errs
+	}
+
+	if oldGroup.Spec.ApiType != newGroup.Spec.ApiType || oldGroup.Spec.Phase != newGroup.Spec.Phase {
+		errs.AddSeveref(
+			"the API type and phase of shared policy group [%s] cannot be changed", newGroup.Spec.Name,
+		)
+	}
+
+	return errs
+}
+
+// Proxy APIs only execute flows on requests and responses,
+// while message APIs can also execute them on published and subscribed messages.
+func validatePhase(group *v1alpha1.SharedPolicyGroup) *errors.AdmissionError {
+	if group.Spec.ApiType != v4.ProxyType {
+		return nil
+	}
+
+	if group.Spec.Phase != v4.RequestPhase && group.Spec.Phase != v4.ResponsePhase {
+		return errors.NewSeveref(
+			"shared policy group [%s] cannot use the %s phase with %s APIs",
+			group.Spec.Name, group.Spec.Phase, group.Spec.ApiType,
+		)
+	}
+
+	return nil
+}
+
+func validateSteps(group *v1alpha1.SharedPolicyGroup) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	for i, step := range group.Spec.Steps {
+		if step == nil {
+			continue
+		}
+		if step.HasSharedPolicyGroupRef() {
+			errs.AddSeveref(
+				"step %d of shared policy group [%s] cannot reference another shared policy group",
+				i, group.Spec.Name,
+			)
+		}
+		if step.Policy == "" {
+			errs.AddSeveref("step %d of shared policy group [%s] must define a policy", i, group.Spec.Name)
+		}
+	}
+	return errs
+}
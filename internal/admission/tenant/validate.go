@@ -0,0 +1,59 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func validateCreate(ctx context.Context, obj runtime.Object) *errors.AdmissionErrors {
+	errs := errors.NewAdmissionErrors()
+	if tenant, ok := obj.(*v1alpha1.Tenant); ok {
+		errs.Add(ctxref.Validate(ctx, tenant))
+		if errs.IsSevere() {
+			return errs
+		}
+		errs.Add(validateNoConflictingName(ctx, tenant))
+	}
+	return errs
+}
+
+func validateNoConflictingName(ctx context.Context, tenant *v1alpha1.Tenant) *errors.AdmissionError {
+	list := &v1alpha1.TenantList{}
+	if err := k8s.GetClient().List(ctx, list, client.InNamespace(tenant.Namespace)); err != nil {
+		return errors.NewSevere(err.Error())
+	}
+
+	for _, other := range list.Items {
+		if other.Name == tenant.Name {
+			continue
+		}
+		if other.Spec.Name == tenant.Spec.Name && other.Spec.Context.String() == tenant.Spec.Context.String() {
+			return errors.NewSeveref(
+				"tenant [%s] is already defined by resource [%s] for the same management context",
+				tenant.Spec.Name, other.Name,
+			)
+		}
+	}
+
+	return nil
+}
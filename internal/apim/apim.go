@@ -20,15 +20,26 @@ import (
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/service"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/http"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s/dynamic"
 )
 
 // APIM wraps services needed to sync resources with a given environment on a Gravitee.io APIM instance.
 type APIM struct {
-	APIs         *service.APIs
-	Applications *service.Applications
-	Env          *service.Env
+	APIs               *service.APIs
+	Applications       *service.Applications
+	Groups             *service.Groups
+	Categories         *service.Categories
+	Dictionaries       *service.Dictionaries
+	SharedPolicyGroups *service.SharedPolicyGroups
+	Tenants            *service.Tenants
+	ShardingTags       *service.ShardingTags
+	Roles              *service.Roles
+	IdentityProviders  *service.IdentityProviders
+	Portal             *service.Portal
+	Env                *service.Env
+	Org                *service.Org
 
 	Context core.ContextModel
 }
@@ -57,15 +68,29 @@ func FromContext(ctx context.Context, context core.ContextModel, parentNs string
 	}
 
 	return &APIM{
-		APIs:         service.NewAPIs(client),
-		Applications: service.NewApplications(client),
-		Env:          service.NewEnv(client),
-		Context:      context,
+		APIs:               service.NewAPIs(client),
+		Applications:       service.NewApplications(client),
+		Groups:             service.NewGroups(client),
+		Categories:         service.NewCategories(client),
+		Dictionaries:       service.NewDictionaries(client),
+		SharedPolicyGroups: service.NewSharedPolicyGroups(client),
+		Tenants:            service.NewTenants(client),
+		ShardingTags:       service.NewShardingTags(client),
+		Roles:              service.NewRoles(client),
+		IdentityProviders:  service.NewIdentityProviders(client),
+		Portal:             service.NewPortal(client),
+		Env:                service.NewEnv(client),
+		Org:                service.NewOrg(client),
+		Context:            context,
 	}, nil
 }
 
 func FromContextRef(ctx context.Context, ref core.ObjectRef, parentNs string) (*APIM, error) {
 	context, err := dynamic.ResolveContext(ctx, ref, parentNs)
+	if dynamic.IsNamespaceNotAllowed(err) {
+		// the resource is synced again once the context allows its namespace
+		return nil, errors.NewUnrecoverableError(err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
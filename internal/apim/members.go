@@ -0,0 +1,44 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apim
+
+import (
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
+)
+
+// ProvisionMembers pre-registers the given users in the organization if they do not exist yet.
+// Only users from an identity source configured for provisioning are considered.
+func (apim *APIM) ProvisionMembers(users ...*model.User) error {
+	for _, user := range users {
+		if !env.IsProvisioningSource(user.Source) {
+			continue
+		}
+
+		existing, err := apim.Org.FindUser(user.Source, user.SourceID)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil {
+			continue
+		}
+
+		if err = apim.Org.CreateUser(user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
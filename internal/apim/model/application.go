@@ -29,6 +29,7 @@ type Application struct {
 	Groups      []string             `json:"groups,omitempty"`
 	Picture     string               `json:"picture,omitempty"`
 	AppType     string               `json:"type,omitempty"`
+	Owner       *PrimaryOwner        `json:"owner,omitempty"`
 }
 
 type ApplicationMetaData struct {
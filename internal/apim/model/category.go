@@ -0,0 +1,26 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+type Category struct {
+	ID          string `json:"id,omitempty"`
+	Key         string `json:"key,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Order       int    `json:"order"`
+	Hidden      bool   `json:"hidden"`
+	Page        string `json:"page,omitempty"`
+	Picture     string `json:"picture,omitempty"`
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+type Dictionary struct {
+	ID          string              `json:"id,omitempty"`
+	Key         string              `json:"key,omitempty"`
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Type        string              `json:"type"`
+	Properties  map[string]string   `json:"properties,omitempty"`
+	Provider    *DictionaryProvider `json:"provider,omitempty"`
+	Trigger     *DictionaryTrigger  `json:"trigger,omitempty"`
+	State       string              `json:"state,omitempty"`
+}
+
+const dictionaryStarted = "STARTED"
+
+// IsStarted returns true if the dictionary is available to the gateways.
+func (dictionary *Dictionary) IsStarted() bool {
+	return dictionary.State == dictionaryStarted
+}
+
+type DictionaryProvider struct {
+	Type          string                           `json:"type"`
+	Configuration *DictionaryProviderConfiguration `json:"configuration"`
+}
+
+type DictionaryProviderConfiguration struct {
+	URL            string             `json:"url"`
+	Method         string             `json:"method,omitempty"`
+	Headers        []DictionaryHeader `json:"headers,omitempty"`
+	Body           string             `json:"body,omitempty"`
+	Specification  string             `json:"specification"`
+	UseSystemProxy bool               `json:"useSystemProxy"`
+}
+
+type DictionaryHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type DictionaryTrigger struct {
+	Rate int64  `json:"rate"`
+	Unit string `json:"unit"`
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+type Tenant struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type ShardingTag struct {
+	ID               string   `json:"id,omitempty"`
+	Name             string   `json:"name"`
+	Description      string   `json:"description,omitempty"`
+	RestrictedGroups []string `json:"restricted_groups,omitempty"`
+}
+
+// RoleDefinition is the payload used to create or update a custom role.
+// Permissions are sent as lists of CRUD rights (e.g. ["R", "U"]), keyed by permission name.
+type RoleDefinition struct {
+	ID          string              `json:"id,omitempty"`
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Scope       string              `json:"scope"`
+	Default     bool                `json:"default"`
+	Permissions map[string][]string `json:"permissions"`
+}
+
+type IdentityProvider struct {
+	ID                 string                          `json:"id,omitempty"`
+	Name               string                          `json:"name"`
+	Description        string                          `json:"description,omitempty"`
+	Type               string                          `json:"type,omitempty"`
+	Enabled            bool                            `json:"enabled"`
+	Configuration      map[string]interface{}          `json:"configuration,omitempty"`
+	EmailRequired      bool                            `json:"emailRequired"`
+	SyncMappings       bool                            `json:"syncMappings"`
+	UserProfileMapping *IdentityProviderProfileMapping `json:"userProfileMapping,omitempty"`
+	GroupMappings      []IdentityProviderGroupMapping  `json:"groupMappings"`
+	RoleMappings       []IdentityProviderRoleMapping   `json:"roleMappings"`
+}
+
+type IdentityProviderProfileMapping struct {
+	ID        string `json:"id,omitempty"`
+	FirstName string `json:"firstname,omitempty"`
+	LastName  string `json:"lastname,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Picture   string `json:"picture,omitempty"`
+}
+
+type IdentityProviderGroupMapping struct {
+	Condition string   `json:"condition"`
+	Groups    []string `json:"groups"`
+}
+
+type IdentityProviderRoleMapping struct {
+	Condition     string              `json:"condition"`
+	Organizations []string            `json:"organizations,omitempty"`
+	Environments  map[string][]string `json:"environments,omitempty"`
+}
+
+// IdentityProviderActivation references an identity provider activated on an organization.
+type IdentityProviderActivation struct {
+	IdentityProvider string `json:"identityProvider"`
+}
@@ -0,0 +1,35 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+type PrimaryOwner struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName,omitempty"`
+	Email       string `json:"email,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+type ApiOwnershipTransfer struct {
+	UserID        string `json:"userId"`
+	UserReference string `json:"userReference,omitempty"`
+	UserType      string `json:"userType"`
+	PoRole        string `json:"poRole,omitempty"`
+}
+
+type ApplicationOwnershipTransfer struct {
+	ID        string `json:"id"`
+	Reference string `json:"reference,omitempty"`
+	Role      string `json:"role,omitempty"`
+}
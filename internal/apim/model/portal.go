@@ -0,0 +1,83 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+const PortalNextThemeType = "PORTAL_NEXT"
+
+type PortalTheme struct {
+	ID           string                 `json:"id,omitempty"`
+	Name         string                 `json:"name"`
+	Type         string                 `json:"type"`
+	Enabled      bool                   `json:"enabled"`
+	Definition   *PortalThemeDefinition `json:"definition,omitempty"`
+	Logo         string                 `json:"logo,omitempty"`
+	OptionalLogo string                 `json:"optionalLogo,omitempty"`
+	Favicon      string                 `json:"favicon,omitempty"`
+}
+
+type PortalThemeDefinition struct {
+	Color     *PortalThemeColors `json:"color,omitempty"`
+	Font      *PortalThemeFont   `json:"font,omitempty"`
+	CustomCSS string             `json:"customCss,omitempty"`
+}
+
+type PortalThemeColors struct {
+	Primary        string `json:"primary,omitempty"`
+	Secondary      string `json:"secondary,omitempty"`
+	Tertiary       string `json:"tertiary,omitempty"`
+	Error          string `json:"error,omitempty"`
+	PageBackground string `json:"pageBackground,omitempty"`
+	CardBackground string `json:"cardBackground,omitempty"`
+}
+
+type PortalThemeFont struct {
+	FontFamily string `json:"fontFamily,omitempty"`
+}
+
+const ExternalPortalMenuLinkType = "EXTERNAL"
+
+type PortalMenuLink struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name"`
+	Type       string `json:"type,omitempty"`
+	Target     string `json:"target"`
+	Visibility string `json:"visibility,omitempty"`
+	Order      int    `json:"order,omitempty"`
+}
+
+type PortalMenuLinkPage struct {
+	Data []PortalMenuLink `json:"data"`
+}
+
+type PortalPage struct {
+	ID                     string                    `json:"id,omitempty"`
+	Name                   string                    `json:"name"`
+	Type                   string                    `json:"type"`
+	Content                string                    `json:"content,omitempty"`
+	Order                  uint64                    `json:"order"`
+	Published              bool                      `json:"published"`
+	Visibility             string                    `json:"visibility,omitempty"`
+	HomePage               bool                      `json:"homepage"`
+	ParentID               string                    `json:"parentId,omitempty"`
+	Source                 *PageSource               `json:"source,omitempty"`
+	Configuration          map[string]string         `json:"configuration,omitempty"`
+	AccessControls         []PortalPageAccessControl `json:"accessControls,omitempty"`
+	ExcludedAccessControls bool                      `json:"excludedAccessControls"`
+}
+
+type PortalPageAccessControl struct {
+	ReferenceID   string `json:"referenceId"`
+	ReferenceType string `json:"referenceType"`
+}
@@ -0,0 +1,35 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+)
+
+type SharedPolicyGroup struct {
+	ID                  string         `json:"id,omitempty"`
+	CrossID             string         `json:"crossId,omitempty"`
+	Name                string         `json:"name"`
+	Description         string         `json:"description,omitempty"`
+	PrerequisiteMessage string         `json:"prerequisiteMessage,omitempty"`
+	ApiType             string         `json:"apiType"`
+	Phase               string         `json:"phase"`
+	Steps               []*v4.FlowStep `json:"steps"`
+	LifecycleState      string         `json:"lifecycleState,omitempty"`
+}
+
+type SharedPolicyGroupPage struct {
+	Data []SharedPolicyGroup `json:"data"`
+}
@@ -14,14 +14,39 @@
 
 package model
 
+import "strings"
+
 type User struct {
 	ID        string `json:"id,omitempty"`
 	FirstName string `json:"firstname,omitempty"`
 	Email     string `json:"email,omitempty"`
 	LastName  string `json:"lastname,omitempty"`
 	Service   bool   `json:"service,omitempty"`
+	Source    string `json:"source,omitempty"`
+	SourceID  string `json:"sourceId,omitempty"`
+}
+
+type UserPage struct {
+	Data []User `json:"data"`
+}
+
+type Role struct {
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name"`
+	Scope   string `json:"scope"`
+	Default bool   `json:"default,omitempty"`
+	System  bool   `json:"system,omitempty"`
 }
 
 func NewServiceAccount(name string) *User {
 	return &User{LastName: name, Service: true}
 }
+
+// NewUser returns a user to be pre-registered in an organization from the given identity source.
+func NewUser(source, sourceID string) *User {
+	user := &User{Source: source, SourceID: sourceID, LastName: sourceID}
+	if strings.Contains(sourceID, "@") {
+		user.Email = sourceID
+	}
+	return user
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apim
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/owner"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+)
+
+// ResolvePrimaryOwner returns the ID of the APIM user or group referenced as primary owner,
+// or an empty string if no such user or group exists.
+func (apim *APIM) ResolvePrimaryOwner(po *owner.PrimaryOwner) (string, error) {
+	if po.IsGroup() {
+		group, err := apim.Env.FindGroup(po.Reference)
+		if err != nil || group == nil {
+			return "", err
+		}
+		return group.ID, nil
+	}
+
+	user, err := apim.Org.FindUser(po.GetSource(), po.Reference)
+	if err != nil || user == nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+// SyncApiPrimaryOwner transfers the ownership of the API to the given primary owner,
+// unless it already owns the API.
+func (apim *APIM) SyncApiPrimaryOwner(apiID string, po *owner.PrimaryOwner) error {
+	if po == nil {
+		return nil
+	}
+
+	ownerID, err := apim.resolveExistingPrimaryOwner(po)
+	if err != nil {
+		return err
+	}
+
+	current, err := apim.APIs.GetPrimaryOwner(apiID)
+	if err != nil {
+		return err
+	}
+
+	if current != nil && current.ID == ownerID {
+		return nil
+	}
+
+	return apim.APIs.TransferOwnership(apiID, &model.ApiOwnershipTransfer{
+		UserID:        ownerID,
+		UserReference: po.Reference,
+		UserType:      po.GetType(),
+		PoRole:        po.FormerOwnerRole,
+	})
+}
+
+// SyncApplicationPrimaryOwner transfers the ownership of the application to the given primary owner,
+// unless it already owns the application.
+func (apim *APIM) SyncApplicationPrimaryOwner(appID string, po *owner.PrimaryOwner) error {
+	if po == nil {
+		return nil
+	}
+
+	ownerID, err := apim.resolveExistingPrimaryOwner(po)
+	if err != nil {
+		return err
+	}
+
+	app, err := apim.Applications.GetByID(appID)
+	if err != nil {
+		return err
+	}
+
+	if app.Owner != nil && app.Owner.ID == ownerID {
+		return nil
+	}
+
+	return apim.Applications.TransferOwnership(appID, &model.ApplicationOwnershipTransfer{
+		ID:        ownerID,
+		Reference: po.Reference,
+		Role:      po.FormerOwnerRole,
+	})
+}
+
+func (apim *APIM) resolveExistingPrimaryOwner(po *owner.PrimaryOwner) (string, error) {
+	ownerID, err := apim.ResolvePrimaryOwner(po)
+	if err != nil {
+		return "", err
+	}
+	if ownerID == "" {
+		return "", fmt.Errorf("primary owner [%s] does not exist", po)
+	}
+	return ownerID, nil
+}
@@ -85,6 +85,25 @@ func (svc *APIs) GetV4ByID(apiID string) (*v4.Api, error) {
 	return resp, nil
 }
 
+// GetPrimaryOwner returns the primary owner of the API with the given ID.
+func (svc *APIs) GetPrimaryOwner(apiID string) (*model.PrimaryOwner, error) {
+	url := svc.EnvV2Target("apis").WithPath(apiID)
+	resp := new(struct {
+		PrimaryOwner *model.PrimaryOwner `json:"primaryOwner"`
+	})
+
+	if err := svc.HTTP.Get(url.String(), resp); err != nil {
+		return nil, err
+	}
+
+	return resp.PrimaryOwner, nil
+}
+
+func (svc *APIs) TransferOwnership(apiID string, transfer *model.ApiOwnershipTransfer) error {
+	url := svc.EnvV2Target("apis").WithPath(apiID).WithPath("_transfer-ownership")
+	return svc.HTTP.Post(url.String(), transfer, nil)
+}
+
 func (svc *APIs) ImportV2(spec *v2.Api) (*base.Status, error) {
 	return svc.importV2(spec, false)
 }
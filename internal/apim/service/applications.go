@@ -51,7 +51,6 @@ func (svc *Applications) Search(query string, status string) ([]model.Applicatio
 	return *applications, nil
 }
 
-// GetByID For tests purposes only.
 func (svc *Applications) GetByID(appID string) (*model.Application, error) {
 	if appID == "" {
 		return nil, errors.NewNotFoundError()
@@ -112,6 +111,11 @@ func (svc *Applications) createOrUpdate(spec *application.Application, dryRun bo
 	return status, nil
 }
 
+func (svc *Applications) TransferOwnership(appID string, transfer *model.ApplicationOwnershipTransfer) error {
+	url := svc.EnvV1Target(applicationsPath).WithPath(appID).WithPath("members", "transfer_ownership")
+	return svc.HTTP.Post(url.String(), transfer, nil)
+}
+
 func (svc *Applications) Delete(appID string) error {
 	url := svc.EnvV1Target(applicationsPath).WithPath(appID)
 	return svc.HTTP.Delete(url.String(), nil)
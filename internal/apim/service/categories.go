@@ -0,0 +1,151 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+)
+
+const categoriesPath = "configuration/categories"
+
+// Categories brings support for managing gravitee.io APIM categories.
+type Categories struct {
+	*client.Client
+}
+
+func NewCategories(client *client.Client) *Categories {
+	return &Categories{Client: client}
+}
+
+func (svc *Categories) List() ([]model.Category, error) {
+	url := svc.EnvV1Target(categoriesPath)
+
+	categories := make([]model.Category, 0)
+	if err := svc.HTTP.Get(url.String(), &categories); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// CreateOrUpdate updates the category matching the ID of the given category, or creates it if none matches.
+// A category without ID is never adopted: an error is returned if a category with the same name already exists,
+// so that deleting the resource does not remove a category it did not create.
+func (svc *Categories) CreateOrUpdate(category *model.Category) (*model.Category, error) {
+	if category.ID == "" {
+		existing, err := svc.find(category.Name)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, fmt.Errorf(
+				"category [%s] already exists with ID [%s], set this ID in the spec to manage it",
+				category.Name, existing.ID,
+			)
+		}
+	}
+
+	if category.ID != "" {
+		updated, err := svc.update(category)
+		if !errors.IsNotFound(err) {
+			return updated, err
+		}
+		category.ID = ""
+	}
+
+	return svc.create(category)
+}
+
+// FindMissing returns the given category references, either keys, names or IDs,
+// that do not match any category of the environment.
+func (svc *Categories) FindMissing(refs []string) ([]string, error) {
+	missing := make([]string, 0)
+	if len(refs) == 0 {
+		return missing, nil
+	}
+
+	categories, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range refs {
+		if !matchesAny(categories, ref) {
+			missing = append(missing, ref)
+		}
+	}
+
+	return missing, nil
+}
+
+func (svc *Categories) Delete(categoryID string) error {
+	url := svc.EnvV1Target(categoriesPath).WithPath(categoryID)
+	return svc.HTTP.Delete(url.String(), nil)
+}
+
+func (svc *Categories) find(ref string) (*model.Category, error) {
+	categories, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range categories {
+		if matches(&categories[i], ref) {
+			return &categories[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (svc *Categories) create(category *model.Category) (*model.Category, error) {
+	url := svc.EnvV1Target(categoriesPath)
+
+	created := new(model.Category)
+	if err := svc.HTTP.Post(url.String(), category, created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (svc *Categories) update(category *model.Category) (*model.Category, error) {
+	url := svc.EnvV1Target(categoriesPath).WithPath(category.ID)
+
+	updated := new(model.Category)
+	if err := svc.HTTP.Put(url.String(), category, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func matchesAny(categories []model.Category, ref string) bool {
+	for i := range categories {
+		if matches(&categories[i], ref) {
+			return true
+		}
+	}
+	return false
+}
+
+func matches(category *model.Category, ref string) bool {
+	return category.ID == ref || category.Key == ref || strings.EqualFold(category.Name, ref)
+}
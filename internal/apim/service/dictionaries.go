@@ -0,0 +1,149 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+)
+
+const (
+	dictionariesPath  = "configuration/dictionaries"
+	dynamicDictionary = "DYNAMIC"
+)
+
+// Dictionaries brings support for managing gravitee.io APIM dictionaries.
+type Dictionaries struct {
+	*client.Client
+}
+
+func NewDictionaries(client *client.Client) *Dictionaries {
+	return &Dictionaries{Client: client}
+}
+
+func (svc *Dictionaries) List() ([]model.Dictionary, error) {
+	url := svc.EnvV1Target(dictionariesPath)
+
+	dictionaries := make([]model.Dictionary, 0)
+	if err := svc.HTTP.Get(url.String(), &dictionaries); err != nil {
+		return nil, err
+	}
+
+	return dictionaries, nil
+}
+
+// CreateOrUpdate updates the dictionary matching the ID of the given dictionary, or creates it if none matches.
+// A dictionary without ID is never adopted: an error is returned if a dictionary with the same name already exists,
+// so that deleting the resource does not remove a dictionary it did not create.
+func (svc *Dictionaries) CreateOrUpdate(dictionary *model.Dictionary) (*model.Dictionary, error) {
+	if dictionary.ID == "" {
+		existing, err := svc.find(dictionary.Name)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, fmt.Errorf(
+				"dictionary [%s] already exists with ID [%s], set this ID in the spec to manage it",
+				dictionary.Name, existing.ID,
+			)
+		}
+	}
+
+	if dictionary.ID != "" {
+		updated, err := svc.update(dictionary)
+		if !errors.IsNotFound(err) {
+			return updated, err
+		}
+		dictionary.ID = ""
+	}
+
+	return svc.create(dictionary)
+}
+
+// Deploy makes the properties of a manual dictionary available to the gateways,
+// or starts fetching a dynamic dictionary from its provider.
+func (svc *Dictionaries) Deploy(dictionary *model.Dictionary) (*model.Dictionary, error) {
+	action := "_deploy"
+	if dictionary.Type == dynamicDictionary {
+		action = "_start"
+	}
+	return svc.act(dictionary.ID, action)
+}
+
+// Undeploy removes a manual dictionary from the gateways, or stops fetching a dynamic dictionary.
+func (svc *Dictionaries) Undeploy(dictionary *model.Dictionary) (*model.Dictionary, error) {
+	action := "_undeploy"
+	if dictionary.Type == dynamicDictionary {
+		action = "_stop"
+	}
+	return svc.act(dictionary.ID, action)
+}
+
+func (svc *Dictionaries) Delete(dictionaryID string) error {
+	url := svc.EnvV1Target(dictionariesPath).WithPath(dictionaryID)
+	return svc.HTTP.Delete(url.String(), nil)
+}
+
+func (svc *Dictionaries) find(name string) (*model.Dictionary, error) {
+	dictionaries, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range dictionaries {
+		if strings.EqualFold(dictionaries[i].Name, name) {
+			return &dictionaries[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (svc *Dictionaries) create(dictionary *model.Dictionary) (*model.Dictionary, error) {
+	url := svc.EnvV1Target(dictionariesPath)
+
+	created := new(model.Dictionary)
+	if err := svc.HTTP.Post(url.String(), dictionary, created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (svc *Dictionaries) update(dictionary *model.Dictionary) (*model.Dictionary, error) {
+	url := svc.EnvV1Target(dictionariesPath).WithPath(dictionary.ID)
+
+	updated := new(model.Dictionary)
+	if err := svc.HTTP.Put(url.String(), dictionary, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (svc *Dictionaries) act(dictionaryID, action string) (*model.Dictionary, error) {
+	url := svc.EnvV1Target(dictionariesPath).WithPath(dictionaryID, action)
+
+	result := new(model.Dictionary)
+	if err := svc.HTTP.Post(url.String(), nil, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
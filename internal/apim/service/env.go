@@ -33,6 +33,24 @@ func (svc *Env) CreateGroup(group *model.Group) error {
 	return svc.HTTP.Post(url.String(), group, group)
 }
 
+// FindGroup returns the group of the environment with the given name, or nil if no such group exists.
+func (svc *Env) FindGroup(name string) (*model.Group, error) {
+	url := svc.EnvV1Target("configuration").WithPath("groups")
+
+	groups := make([]model.Group, 0)
+	if err := svc.HTTP.Get(url.String(), &groups); err != nil {
+		return nil, err
+	}
+
+	for i := range groups {
+		if groups[i].Name == name {
+			return &groups[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
 func (svc *Env) Get() (*model.Env, error) {
 	env := new(model.Env)
 	if err := svc.HTTP.Get(svc.URLs.EnvV2.String(), env); err != nil {
@@ -0,0 +1,59 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strconv"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/group"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+)
+
+const groupsPath = "/groups"
+
+// Groups brings support for managing gravitee.io APIM groups.
+type Groups struct {
+	*client.Client
+}
+
+func NewGroups(client *client.Client) *Groups {
+	return &Groups{Client: client}
+}
+
+func (svc *Groups) CreateOrUpdate(spec *group.Type) (*group.Status, error) {
+	return svc.createOrUpdate(spec, false)
+}
+
+func (svc *Groups) DryRunCreateOrUpdate(spec *group.Type) (*group.Status, error) {
+	return svc.createOrUpdate(spec, true)
+}
+
+func (svc *Groups) createOrUpdate(spec *group.Type, dryRun bool) (*group.Status, error) {
+	url := svc.EnvV2Target(groupsPath).
+		WithPath("/_import/crd").
+		WithQueryParam("dryRun", strconv.FormatBool(dryRun))
+
+	status := new(group.Status)
+	if err := svc.HTTP.Put(url.String(), spec, status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (svc *Groups) Delete(groupID string) error {
+	url := svc.EnvV2Target(groupsPath).WithPath(groupID)
+	return svc.HTTP.Delete(url.String(), nil)
+}
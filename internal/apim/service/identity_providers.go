@@ -0,0 +1,142 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+)
+
+const (
+	identityProvidersPath = "configuration/identities"
+	activationsPath       = "identities"
+)
+
+// IdentityProviders brings support for managing gravitee.io APIM identity providers
+// and their activation on the organization.
+type IdentityProviders struct {
+	*client.Client
+}
+
+func NewIdentityProviders(client *client.Client) *IdentityProviders {
+	return &IdentityProviders{Client: client}
+}
+
+// Find returns the identity provider matching the given name, or nil if no such identity provider exists.
+func (svc *IdentityProviders) Find(name string) (*model.IdentityProvider, error) {
+	url := svc.OrgTarget(identityProvidersPath)
+
+	providers := make([]model.IdentityProvider, 0)
+	if err := svc.HTTP.Get(url.String(), &providers); err != nil {
+		return nil, err
+	}
+
+	for i := range providers {
+		if strings.EqualFold(providers[i].Name, name) {
+			return &providers[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateOrUpdate updates the identity provider matching the ID of the given identity provider.
+// The identity provider with the same name is updated when no ID is known yet, that is on first sync.
+// The identity provider is created if none matches.
+func (svc *IdentityProviders) CreateOrUpdate(provider *model.IdentityProvider) (*model.IdentityProvider, error) {
+	if provider.ID == "" {
+		existing, err := svc.Find(provider.Name)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			provider.ID = existing.ID
+		}
+	}
+
+	if provider.ID != "" {
+		updated, err := svc.update(provider)
+		if !errors.IsNotFound(err) {
+			return updated, err
+		}
+		provider.ID = ""
+	}
+
+	created := new(model.IdentityProvider)
+	if err := svc.HTTP.Post(svc.OrgTarget(identityProvidersPath).String(), provider, created); err != nil {
+		return nil, err
+	}
+	if provider.Enabled {
+		return created, nil
+	}
+
+	// Identity providers are always enabled on creation
+	provider.ID = created.ID
+	return svc.update(provider)
+}
+
+func (svc *IdentityProviders) Delete(id string) error {
+	url := svc.OrgTarget(identityProvidersPath).WithPath(id)
+	return svc.HTTP.Delete(url.String(), nil)
+}
+
+// SetActivated activates or deactivates the given identity provider on the organization,
+// keeping the activation of other identity providers unchanged.
+func (svc *IdentityProviders) SetActivated(id string, activated bool) error {
+	url := svc.OrgTarget(activationsPath)
+
+	current := make([]model.IdentityProviderActivation, 0)
+	if err := svc.HTTP.Get(url.String(), &current); err != nil {
+		return err
+	}
+
+	activations := make([]model.IdentityProviderActivation, 0, len(current)+1)
+	isActivated := false
+	for _, activation := range current {
+		if activation.IdentityProvider == id {
+			isActivated = true
+			if !activated {
+				continue
+			}
+		}
+		activations = append(activations, model.IdentityProviderActivation{
+			IdentityProvider: activation.IdentityProvider,
+		})
+	}
+
+	if isActivated == activated {
+		return nil
+	}
+
+	if activated {
+		activations = append(activations, model.IdentityProviderActivation{IdentityProvider: id})
+	}
+
+	return svc.HTTP.Put(url.String(), activations, nil)
+}
+
+func (svc *IdentityProviders) update(provider *model.IdentityProvider) (*model.IdentityProvider, error) {
+	url := svc.OrgTarget(identityProvidersPath).WithPath(provider.ID)
+
+	updated := new(model.IdentityProvider)
+	if err := svc.HTTP.Put(url.String(), provider, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
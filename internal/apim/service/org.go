@@ -15,12 +15,13 @@
 package service
 
 import (
+	"strings"
+
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
 )
 
 // Org brings support for managing gravitee.io APIM support for organization level operations.
-// This service is used for testing purposes only and not initialized by the operator manager.
 type Org struct {
 	*client.Client
 }
@@ -33,3 +34,35 @@ func (svc *Org) CreateUser(user *model.User) error {
 	url := svc.OrgTarget("users")
 	return svc.HTTP.Post(url.String(), user, user)
 }
+
+// FindUser returns the user of the organization matching the given source and source ID,
+// or nil if no such user exists.
+func (svc *Org) FindUser(source, sourceID string) (*model.User, error) {
+	url := svc.OrgTarget("users").WithQueryParam("q", sourceID)
+
+	page := new(model.UserPage)
+	if err := svc.HTTP.Get(url.String(), page); err != nil {
+		return nil, err
+	}
+
+	for i := range page.Data {
+		user := &page.Data[i]
+		if strings.EqualFold(user.Source, source) && strings.EqualFold(user.SourceID, sourceID) {
+			return user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// GetRoles returns the roles defined in the organization for the given scope (e.g. API or APPLICATION).
+func (svc *Org) GetRoles(scope string) ([]model.Role, error) {
+	url := svc.OrgTarget("configuration").WithPath("rolescopes", scope, "roles")
+
+	roles := make([]model.Role, 0)
+	if err := svc.HTTP.Get(url.String(), &roles); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
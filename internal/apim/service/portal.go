@@ -0,0 +1,248 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+)
+
+const (
+	portalThemesPath        = "ui/themes"
+	portalMenuLinksPath     = "ui/portal-menu-links"
+	portalMenuLinksPageSize = "100"
+	portalPagesPath         = "portal/pages"
+)
+
+// Portal brings support for managing the theme and the navigation links of the gravitee.io APIM developer portal.
+// It also manages the documentation pages of the portal.
+type Portal struct {
+	*client.Client
+}
+
+func NewPortal(client *client.Client) *Portal {
+	return &Portal{Client: client}
+}
+
+// CurrentTheme returns the theme currently applied to the portal of the environment.
+func (svc *Portal) CurrentTheme() (*model.PortalTheme, error) {
+	return svc.getTheme("_current")
+}
+
+// DefaultTheme returns the theme shipped with the portal, used to reset the current theme.
+func (svc *Portal) DefaultTheme() (*model.PortalTheme, error) {
+	return svc.getTheme("_default")
+}
+
+func (svc *Portal) UpdateTheme(theme *model.PortalTheme) (*model.PortalTheme, error) {
+	url := svc.EnvV2Target(portalThemesPath).WithPath(theme.ID)
+
+	updated := new(model.PortalTheme)
+	if err := svc.HTTP.Put(url.String(), theme, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (svc *Portal) ListMenuLinks() ([]model.PortalMenuLink, error) {
+	url := svc.EnvV2Target(portalMenuLinksPath).WithQueryParam("perPage", portalMenuLinksPageSize)
+
+	page := new(model.PortalMenuLinkPage)
+	if err := svc.HTTP.Get(url.String(), page); err != nil {
+		return nil, err
+	}
+
+	return page.Data, nil
+}
+
+func (svc *Portal) CreateMenuLink(link *model.PortalMenuLink) (*model.PortalMenuLink, error) {
+	url := svc.EnvV2Target(portalMenuLinksPath)
+
+	created := new(model.PortalMenuLink)
+	if err := svc.HTTP.Post(url.String(), link, created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (svc *Portal) UpdateMenuLink(link *model.PortalMenuLink) (*model.PortalMenuLink, error) {
+	url := svc.EnvV2Target(portalMenuLinksPath).WithPath(link.ID)
+
+	updated := new(model.PortalMenuLink)
+	if err := svc.HTTP.Put(url.String(), link, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (svc *Portal) DeleteMenuLink(linkID string) error {
+	url := svc.EnvV2Target(portalMenuLinksPath).WithPath(linkID)
+	return svc.HTTP.Delete(url.String(), nil)
+}
+
+// SyncMenuLinks creates or updates the given navigation links, and deletes the links previously created
+// by a resource that are no longer given. The managed map holds the IDs of the links created by the resource,
+// keyed by name. The returned map replaces it and is returned even if an error occurs,
+// so that no created link is lost track of.
+func (svc *Portal) SyncMenuLinks(
+	links []*model.PortalMenuLink,
+	managed map[string]string,
+) (map[string]string, error) {
+	synced := make(map[string]string, len(links))
+
+	existing, err := svc.ListMenuLinks()
+	if err != nil {
+		return managed, err
+	}
+
+	known := make(map[string]bool, len(existing))
+	for _, link := range existing {
+		known[link.ID] = true
+	}
+
+	for _, link := range links {
+		var result *model.PortalMenuLink
+		if id, ok := managed[link.Name]; ok && known[id] {
+			link.ID = id
+			result, err = svc.UpdateMenuLink(link)
+		} else {
+			result, err = svc.CreateMenuLink(link)
+		}
+
+		if err != nil {
+			return mergeLinks(synced, managed), err
+		}
+		synced[link.Name] = result.ID
+	}
+
+	for name, id := range managed {
+		if _, ok := synced[name]; ok || !known[id] {
+			continue
+		}
+		if err = svc.DeleteMenuLink(id); errors.IgnoreNotFound(err) != nil {
+			return mergeLinks(synced, managed), err
+		}
+	}
+
+	return synced, nil
+}
+
+func (svc *Portal) getTheme(name string) (*model.PortalTheme, error) {
+	url := svc.EnvV2Target(portalThemesPath).WithPath(name).WithQueryParam("type", model.PortalNextThemeType)
+
+	theme := new(model.PortalTheme)
+	if err := svc.HTTP.Get(url.String(), theme); err != nil {
+		return nil, err
+	}
+
+	return theme, nil
+}
+
+// FindPage returns the portal page with the given name under the given parent folder,
+// or at the root of the portal documentation if no parent is given.
+func (svc *Portal) FindPage(name, parentID string) (*model.PortalPage, error) {
+	url := svc.EnvV1Target(portalPagesPath).WithQueryParam("name", name)
+	if parentID == "" {
+		url = url.WithQueryParam("root", "true")
+	} else {
+		url = url.WithQueryParam("parent", parentID)
+	}
+
+	pages := make([]model.PortalPage, 0)
+	if err := svc.HTTP.Get(url.String(), &pages); err != nil {
+		return nil, err
+	}
+
+	for i := range pages {
+		if pages[i].Name == name {
+			return &pages[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateOrUpdatePage updates the portal page matching the ID of the given page, or creates it if none matches.
+// A page without ID is never adopted: an error is returned if a page with the same name already exists
+// under the same parent, so that deleting the resource does not remove a page it did not create.
+func (svc *Portal) CreateOrUpdatePage(page *model.PortalPage) (*model.PortalPage, error) {
+	if page.ID == "" {
+		existing, err := svc.FindPage(page.Name, page.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, fmt.Errorf(
+				"portal page [%s] already exists with ID [%s] in the same folder, remove or rename it to manage this page",
+				page.Name, existing.ID,
+			)
+		}
+	}
+
+	if page.ID != "" {
+		updated, err := svc.updatePage(page)
+		if !errors.IsNotFound(err) {
+			return updated, err
+		}
+		page.ID = ""
+	}
+
+	return svc.createPage(page)
+}
+
+func (svc *Portal) DeletePage(pageID string) error {
+	url := svc.EnvV1Target(portalPagesPath).WithPath(pageID)
+	return svc.HTTP.Delete(url.String(), nil)
+}
+
+func (svc *Portal) createPage(page *model.PortalPage) (*model.PortalPage, error) {
+	url := svc.EnvV1Target(portalPagesPath)
+
+	created := new(model.PortalPage)
+	if err := svc.HTTP.Post(url.String(), page, created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (svc *Portal) updatePage(page *model.PortalPage) (*model.PortalPage, error) {
+	url := svc.EnvV1Target(portalPagesPath).WithPath(page.ID)
+
+	updated := new(model.PortalPage)
+	if err := svc.HTTP.Put(url.String(), page, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// mergeLinks keeps track of links that have been synced as well as links still to be deleted.
+func mergeLinks(synced, managed map[string]string) map[string]string {
+	result := make(map[string]string, len(synced)+len(managed))
+	for name, id := range managed {
+		result[name] = id
+	}
+	for name, id := range synced {
+		result[name] = id
+	}
+	return result
+}
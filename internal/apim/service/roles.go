@@ -0,0 +1,81 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/http"
+)
+
+// Roles brings support for managing gravitee.io APIM custom roles, defined at the organization level.
+type Roles struct {
+	*client.Client
+}
+
+func NewRoles(client *client.Client) *Roles {
+	return &Roles{Client: client}
+}
+
+// Find returns the role of the given scope matching the given name, or nil if no such role exists.
+func (svc *Roles) Find(scope, name string) (*model.Role, error) {
+	roles := make([]model.Role, 0)
+	if err := svc.HTTP.Get(svc.target(scope).String(), &roles); err != nil {
+		return nil, err
+	}
+
+	for i := range roles {
+		if strings.EqualFold(roles[i].Name, name) {
+			return &roles[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateOrUpdate updates the role of the same scope and name as the given role, or creates it if none matches.
+func (svc *Roles) CreateOrUpdate(role *model.RoleDefinition) (*model.Role, error) {
+	existing, err := svc.Find(role.Scope, role.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	synced := new(model.Role)
+	if existing != nil {
+		role.ID = existing.ID
+		role.Name = existing.Name
+		url := svc.target(role.Scope).WithPath(existing.Name)
+		err = svc.HTTP.Put(url.String(), role, synced)
+	} else {
+		err = svc.HTTP.Post(svc.target(role.Scope).String(), role, synced)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return synced, nil
+}
+
+func (svc *Roles) Delete(scope, name string) error {
+	url := svc.target(scope).WithPath(name)
+	return svc.HTTP.Delete(url.String(), nil)
+}
+
+func (svc *Roles) target(scope string) *http.URL {
+	return svc.OrgTarget("configuration").WithPath("rolescopes", scope, "roles")
+}
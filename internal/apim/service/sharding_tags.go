@@ -0,0 +1,118 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+)
+
+const shardingTagsPath = "configuration/tags"
+
+// ShardingTags brings support for managing gravitee.io APIM sharding tags, defined at the organization level.
+type ShardingTags struct {
+	*client.Client
+}
+
+func NewShardingTags(client *client.Client) *ShardingTags {
+	return &ShardingTags{Client: client}
+}
+
+func (svc *ShardingTags) List() ([]model.ShardingTag, error) {
+	url := svc.OrgTarget(shardingTagsPath)
+
+	tags := make([]model.ShardingTag, 0)
+	if err := svc.HTTP.Get(url.String(), &tags); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// ListAllowed returns the IDs of the sharding tags the user of the client is allowed to deploy APIs on.
+func (svc *ShardingTags) ListAllowed() ([]string, error) {
+	url := svc.EnvV1Target("user/tags")
+
+	tags := make([]string, 0)
+	if err := svc.HTTP.Get(url.String(), &tags); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// CreateOrUpdate updates the sharding tag with the same name as the given tag, or creates it if none matches.
+func (svc *ShardingTags) CreateOrUpdate(tag *model.ShardingTag) (*model.ShardingTag, error) {
+	existing, err := svc.find(tag.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		tag.ID = existing.ID
+		updated, uErr := svc.update(tag)
+		if !errors.IsNotFound(uErr) {
+			return updated, uErr
+		}
+		tag.ID = ""
+	}
+
+	return svc.create(tag)
+}
+
+func (svc *ShardingTags) Delete(tagID string) error {
+	url := svc.OrgTarget(shardingTagsPath).WithPath(tagID)
+	return svc.HTTP.Delete(url.String(), nil)
+}
+
+func (svc *ShardingTags) find(name string) (*model.ShardingTag, error) {
+	tags, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range tags {
+		if strings.EqualFold(tags[i].Name, name) {
+			return &tags[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (svc *ShardingTags) create(tag *model.ShardingTag) (*model.ShardingTag, error) {
+	url := svc.OrgTarget(shardingTagsPath)
+
+	created := new(model.ShardingTag)
+	if err := svc.HTTP.Post(url.String(), tag, created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (svc *ShardingTags) update(tag *model.ShardingTag) (*model.ShardingTag, error) {
+	url := svc.OrgTarget(shardingTagsPath).WithPath(tag.ID)
+
+	updated := new(model.ShardingTag)
+	if err := svc.HTTP.Put(url.String(), tag, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+)
+
+const (
+	sharedPolicyGroupsPath     = "shared-policy-groups"
+	sharedPolicyGroupsPageSize = "1000"
+)
+
+// SharedPolicyGroups brings support for managing gravitee.io APIM shared policy groups.
+type SharedPolicyGroups struct {
+	*client.Client
+}
+
+func NewSharedPolicyGroups(client *client.Client) *SharedPolicyGroups {
+	return &SharedPolicyGroups{Client: client}
+}
+
+func (svc *SharedPolicyGroups) List() ([]model.SharedPolicyGroup, error) {
+	url := svc.EnvV2Target(sharedPolicyGroupsPath).WithQueryParam("perPage", sharedPolicyGroupsPageSize)
+
+	page := new(model.SharedPolicyGroupPage)
+	if err := svc.HTTP.Get(url.String(), page); err != nil {
+		return nil, err
+	}
+
+	return page.Data, nil
+}
+
+// CreateOrUpdate updates the shared policy group matching the ID of the given group,
+// or the group with the same cross ID if no ID is given. The group is created if none matches.
+// The API type and phase of a group cannot change once the group has been created.
+func (svc *SharedPolicyGroups) CreateOrUpdate(group *model.SharedPolicyGroup) (*model.SharedPolicyGroup, error) {
+	if group.ID == "" {
+		existing, err := svc.findByCrossID(group.CrossID)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			group.ID = existing.ID
+		}
+	}
+
+	if group.ID != "" {
+		updated, err := svc.update(group)
+		if !errors.IsNotFound(err) {
+			return updated, err
+		}
+		group.ID = ""
+	}
+
+	return svc.create(group)
+}
+
+// Deploy makes the current version of the shared policy group available to the gateways.
+func (svc *SharedPolicyGroups) Deploy(groupID string) (*model.SharedPolicyGroup, error) {
+	return svc.act(groupID, "_deploy")
+}
+
+// Undeploy removes the shared policy group from the gateways.
+func (svc *SharedPolicyGroups) Undeploy(groupID string) (*model.SharedPolicyGroup, error) {
+	return svc.act(groupID, "_undeploy")
+}
+
+func (svc *SharedPolicyGroups) Delete(groupID string) error {
+	url := svc.EnvV2Target(sharedPolicyGroupsPath).WithPath(groupID)
+	return svc.HTTP.Delete(url.String(), nil)
+}
+
+func (svc *SharedPolicyGroups) findByCrossID(crossID string) (*model.SharedPolicyGroup, error) {
+	groups, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range groups {
+		if groups[i].CrossID == crossID {
+			return &groups[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (svc *SharedPolicyGroups) create(group *model.SharedPolicyGroup) (*model.SharedPolicyGroup, error) {
+	url := svc.EnvV2Target(sharedPolicyGroupsPath)
+
+	created := new(model.SharedPolicyGroup)
+	if err := svc.HTTP.Post(url.String(), group, created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (svc *SharedPolicyGroups) update(group *model.SharedPolicyGroup) (*model.SharedPolicyGroup, error) {
+	url := svc.EnvV2Target(sharedPolicyGroupsPath).WithPath(group.ID)
+
+	updated := new(model.SharedPolicyGroup)
+	if err := svc.HTTP.Put(url.String(), group, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (svc *SharedPolicyGroups) act(groupID, action string) (*model.SharedPolicyGroup, error) {
+	url := svc.EnvV2Target(sharedPolicyGroupsPath).WithPath(groupID, action)
+
+	result := new(model.SharedPolicyGroup)
+	if err := svc.HTTP.Post(url.String(), nil, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
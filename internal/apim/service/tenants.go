@@ -0,0 +1,118 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+)
+
+const tenantsPath = "configuration/tenants"
+
+// Tenants brings support for managing gravitee.io APIM tenants, defined at the organization level.
+type Tenants struct {
+	*client.Client
+}
+
+func NewTenants(client *client.Client) *Tenants {
+	return &Tenants{Client: client}
+}
+
+func (svc *Tenants) List() ([]model.Tenant, error) {
+	url := svc.OrgTarget(tenantsPath)
+
+	tenants := make([]model.Tenant, 0)
+	if err := svc.HTTP.Get(url.String(), &tenants); err != nil {
+		return nil, err
+	}
+
+	return tenants, nil
+}
+
+// CreateOrUpdate updates the tenant with the same name as the given tenant, or creates it if none matches.
+func (svc *Tenants) CreateOrUpdate(tenant *model.Tenant) (*model.Tenant, error) {
+	existing, err := svc.find(tenant.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	url := svc.OrgTarget(tenantsPath)
+	synced := make([]model.Tenant, 0)
+	if existing != nil {
+		tenant.ID = existing.ID
+		err = svc.HTTP.Put(url.String(), []*model.Tenant{tenant}, &synced)
+	} else {
+		err = svc.HTTP.Post(url.String(), []*model.Tenant{tenant}, &synced)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(synced) == 0 {
+		return nil, fmt.Errorf("tenant [%s] has not been returned by APIM", tenant.Name)
+	}
+
+	return &synced[0], nil
+}
+
+// FindMissing returns the given tenant IDs that do not match any tenant of the organization.
+func (svc *Tenants) FindMissing(ids []string) ([]string, error) {
+	missing := make([]string, 0)
+	if len(ids) == 0 {
+		return missing, nil
+	}
+
+	tenants, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(tenants))
+	for _, tenant := range tenants {
+		known[tenant.ID] = true
+	}
+
+	for _, id := range ids {
+		if !known[id] {
+			missing = append(missing, id)
+		}
+	}
+
+	return missing, nil
+}
+
+func (svc *Tenants) Delete(tenantID string) error {
+	url := svc.OrgTarget(tenantsPath).WithPath(tenantID)
+	return svc.HTTP.Delete(url.String(), nil)
+}
+
+func (svc *Tenants) find(name string) (*model.Tenant, error) {
+	tenants, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range tenants {
+		if strings.EqualFold(tenants[i].Name, name) {
+			return &tenants[i], nil
+		}
+	}
+
+	return nil, nil
+}
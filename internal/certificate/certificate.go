@@ -0,0 +1,118 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificate
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const pemCertificateType = "CERTIFICATE"
+
+// Info holds the validity period of a certificate found in a secret key.
+type Info struct {
+	Key       string
+	Subject   string
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+func (i Info) IsExpired(now time.Time) bool {
+	return now.After(i.NotAfter)
+}
+
+func (i Info) IsNotYetValid(now time.Time) bool {
+	return now.Before(i.NotBefore)
+}
+
+// ExpiresIn returns the remaining validity of the certificate.
+func (i Info) ExpiresIn(now time.Time) time.Duration {
+	return i.NotAfter.Sub(now)
+}
+
+func (i Info) String() string {
+	return fmt.Sprintf("certificate [%s] in key [%s]", i.Subject, i.Key)
+}
+
+// Find returns all the certificates found in the PEM encoded values of the secret,
+// such as the TLS certificate and chain of a key pair or the certificates of a CA bundle.
+// Values that are not PEM encoded are ignored.
+func Find(secret *v1.Secret) []Info {
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	infos := make([]Info, 0)
+	for _, key := range keys {
+		infos = append(infos, parse(key, secret.Data[key])...)
+	}
+	return infos
+}
+
+func parse(key string, data []byte) []Info {
+	infos := make([]Info, 0)
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != pemCertificateType {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		infos = append(infos, Info{
+			Key:       key,
+			Subject:   cert.Subject.String(),
+			NotBefore: cert.NotBefore,
+			NotAfter:  cert.NotAfter,
+		})
+	}
+	return infos
+}
+
+// ValidateKeyPair checks that the PEM encoded certificate matches the private key
+// and that the leaf certificate is valid at the given time.
+func ValidateKeyPair(crt, key []byte, now time.Time) error {
+	keyPair, err := tls.X509KeyPair(crt, key)
+	if err != nil {
+		return fmt.Errorf("invalid key pair: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return err
+	}
+
+	if now.After(leaf.NotAfter) {
+		return fmt.Errorf(
+			"certificate [%s] expired on %s", leaf.Subject, leaf.NotAfter.Format(time.RFC3339),
+		)
+	}
+
+	if now.Before(leaf.NotBefore) {
+		return fmt.Errorf(
+			"certificate [%s] is not valid before %s", leaf.Subject, leaf.NotBefore.Format(time.RFC3339),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificate
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	ExpiredReason       = "CertificateExpired"
+	ExpiringReason      = "CertificateExpiring"
+	NotYetValidReason   = "CertificateNotYetValid"
+	expiryCheckInterval = time.Second
+)
+
+// Warning describes a certificate that is expired, not yet valid,
+// or that has reached one of the configured expiry thresholds.
+type Warning struct {
+	Reason  string
+	Message string
+	// Threshold is the shortest expiry threshold reached by an expiring certificate.
+	Threshold time.Duration
+}
+
+// level identifies the state of a certificate a warning has been emitted for,
+// so that the warning is only emitted once for each threshold crossing.
+func (w *Warning) level() string {
+	return fmt.Sprintf("%s/%s", w.Reason, w.Threshold)
+}
+
+// CheckExpiry returns a warning if the certificate is not valid at the given time or
+// if its remaining validity is below one of the thresholds. It also returns the delay
+// after which the certificate should be checked again, zero meaning that it has expired.
+func CheckExpiry(info Info, thresholds []time.Duration, now time.Time) (*Warning, time.Duration) {
+	if info.IsExpired(now) {
+		return &Warning{
+			Reason:  ExpiredReason,
+			Message: fmt.Sprintf("%s expired on %s", info, info.NotAfter.Format(time.RFC3339)),
+		}, 0
+	}
+
+	if info.IsNotYetValid(now) {
+		return &Warning{
+			Reason:  NotYetValidReason,
+			Message: fmt.Sprintf("%s is not valid before %s", info, info.NotBefore.Format(time.RFC3339)),
+		}, info.NotBefore.Sub(now)
+	}
+
+	remaining := info.ExpiresIn(now)
+	next := remaining + expiryCheckInterval
+	var warning *Warning
+
+	for _, threshold := range thresholds {
+		if remaining <= threshold {
+			warning = &Warning{
+				Reason: ExpiringReason,
+				Message: fmt.Sprintf(
+					"%s expires in %s, on %s", info, remaining.Round(time.Minute), info.NotAfter.Format(time.RFC3339),
+				),
+				Threshold: threshold,
+			}
+			continue
+		}
+		if delay := remaining - threshold; delay < next {
+			next = delay
+		}
+	}
+
+	return warning, next
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificate
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespaceLabel = "namespace"
+	secretLabel    = "secret"
+	keyLabel       = "key"
+	subjectLabel   = "subject"
+)
+
+var (
+	labels = []string{namespaceLabel, secretLabel, keyLabel, subjectLabel}
+
+	expiryTimestamp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gko_certificate_expiry_timestamp_seconds",
+		Help: "The date after which a certificate used by the operator expires, expressed as a Unix Epoch Time.",
+	}, labels)
+
+	notBeforeTimestamp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gko_certificate_not_before_timestamp_seconds",
+		Help: "The date before which a certificate used by the operator is not valid, expressed as a Unix Epoch Time.",
+	}, labels)
+)
+
+func init() {
+	metrics.Registry.MustRegister(expiryTimestamp, notBeforeTimestamp)
+}
+
+// Record replaces the metrics of a secret with the validity dates of its certificates.
+func Record(namespace, secret string, infos []Info) {
+	Forget(namespace, secret)
+	for _, info := range infos {
+		values := prometheus.Labels{
+			namespaceLabel: namespace,
+			secretLabel:    secret,
+			keyLabel:       info.Key,
+			subjectLabel:   info.Subject,
+		}
+		expiryTimestamp.With(values).Set(float64(info.NotAfter.Unix()))
+		notBeforeTimestamp.With(values).Set(float64(info.NotBefore.Unix()))
+	}
+}
+
+// Forget removes the metrics of a secret.
+func Forget(namespace, secret string) {
+	values := prometheus.Labels{namespaceLabel: namespace, secretLabel: secret}
+	expiryTimestamp.DeletePartialMatch(values)
+	notBeforeTimestamp.DeletePartialMatch(values)
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificate
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// notified holds, for each monitored certificate, the level of the last warning emitted.
+var notified = struct {
+	sync.Mutex
+	levels map[string]string
+}{levels: make(map[string]string)}
+
+// ShouldNotify returns true if the warning has not been emitted yet for the certificate,
+// that is if the certificate has crossed a new threshold since the last warning.
+// A nil warning resets the state of the certificate, e.g. after it has been renewed.
+func ShouldNotify(namespace, secret string, info Info, warning *Warning) bool {
+	notified.Lock()
+	defer notified.Unlock()
+
+	key := notificationKey(namespace, secret, info)
+	if warning == nil {
+		delete(notified.levels, key)
+		return false
+	}
+
+	if notified.levels[key] == warning.level() {
+		return false
+	}
+
+	notified.levels[key] = warning.level()
+	return true
+}
+
+// Retain drops the notification state of the certificates that are no longer held by the secret.
+func Retain(namespace, secret string, infos []Info) {
+	notified.Lock()
+	defer notified.Unlock()
+
+	kept := make(map[string]bool, len(infos))
+	for _, info := range infos {
+		kept[notificationKey(namespace, secret, info)] = true
+	}
+
+	prefix := notificationPrefix(namespace, secret)
+	for key := range notified.levels {
+		if strings.HasPrefix(key, prefix) && !kept[key] {
+			delete(notified.levels, key)
+		}
+	}
+}
+
+func notificationPrefix(namespace, secret string) string {
+	return fmt.Sprintf("%s/%s/", namespace, secret)
+}
+
+func notificationKey(namespace, secret string, info Info) string {
+	return fmt.Sprintf("%s%s/%s/%d", notificationPrefix(namespace, secret), info.Key, info.Subject, info.NotAfter.Unix())
+}
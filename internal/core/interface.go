@@ -175,6 +175,7 @@ type ObjectRef interface {
 	NamespacedName() types.NamespacedName
 	GetName() string
 	GetNamespace() string
+	GetKind() string
 	HasNameSpace() bool
 	IsMissingNamespace() bool
 	SetNamespace(ns string)
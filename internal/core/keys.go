@@ -18,31 +18,63 @@ const (
 	CRDGroup   = "gravitee.io"
 	CRDVersion = "v1alpha1"
 
-	CRDManagementContextResource = "managementcontexts"
-	CRDApiDefinitionResource     = "apidefinitions"
-	CRDApiV4DefinitionResource   = "apiv4definitions"
-	CRDResourceResource          = "apiresources"
-
-	GraviteeComponentLabel      = "gravitee.io/component"
-	IngressLabel                = "gravitee.io/ingress"
-	IngressLabelValue           = "graviteeio"
-	IngressClassAnnotation      = "kubernetes.io/ingress.class"
-	IngressClassAnnotationValue = "graviteeio"
-	IngressTemplateAnnotation   = "gravitee.io/template"
-	GraviteePemRegistryLabel    = "kubernetes-pem-registry"
-	LastSpecHashAnnotation      = "gravitee.io/last-spec-hash"
+	CRDManagementContextResource        = "managementcontexts"
+	CRDClusterManagementContextResource = "clustermanagementcontexts"
+	CRDApiDefinitionResource            = "apidefinitions"
+	CRDApiV4DefinitionResource          = "apiv4definitions"
+	CRDResourceResource                 = "apiresources"
+	CRDGroupResource                    = "groups"
+	CRDCategoryResource                 = "categories"
+	CRDDictionaryResource               = "dictionaries"
+	CRDSharedPolicyGroupResource        = "sharedpolicygroups"
+	CRDTenantResource                   = "tenants"
+	CRDShardingTagResource              = "shardingtags"
+	CRDRoleResource                     = "roles"
+	CRDIdentityProviderResource         = "identityproviders"
+	CRDPortalThemeResource              = "portalthemes"
+	CRDPortalPageResource               = "portalpages"
+
+	CRDIngressClassParametersKind   = "GraviteeIngressClassParameters"
+	CRDManagementContextKind        = "ManagementContext"
+	CRDClusterManagementContextKind = "ClusterManagementContext"
+
+	GraviteeComponentLabel        = "gravitee.io/component"
+	IngressLabel                  = "gravitee.io/ingress"
+	IngressLabelValue             = "graviteeio"
+	IngressClassAnnotation        = "kubernetes.io/ingress.class"
+	IngressClassAnnotationValue   = "graviteeio"
+	IngressTemplateAnnotation     = "gravitee.io/template"
+	IngressCanaryAnnotation       = "gravitee.io/canary"
+	IngressCanaryWeightAnnotation = "gravitee.io/canary-weight"
+	GraviteePemRegistryLabel      = "kubernetes-pem-registry"
+	GraviteeKeystoreLabel         = "kubernetes-keystore"
+	CertificateValidationLabel    = "gravitee.io/validate-certificate"
+	LastSpecHashAnnotation        = "gravitee.io/last-spec-hash"
+	FetchedPagesHashAnnotation    = "gravitee.io/fetched-pages-hash"
+	DefaultContextAnnotation      = "gravitee.io/default-context"
 
 	Extends = "gravitee.io/extends"
 
 	ApiDefinitionFinalizer         = "finalizers.gravitee.io/apidefinitiondeletion"
 	ApiDefinitionTemplateFinalizer = "finalizers.gravitee.io/apidefinitiontemplate"
 	ManagementContextFinalizer     = "finalizers.gravitee.io/managementcontextdeletion"
+	ClusterContextFinalizer        = "finalizers.gravitee.io/clustermanagementcontextdeletion"
 	ApiResourceFinalizer           = "finalizers.gravitee.io/apiresource"
 	//nolint:gosec // This is not an hardcoded secret
 	ManagementContextSecretFinalizer = "finalizers.gravitee.io/managementcontextSecret"
 	IngressFinalizer                 = "finalizers.gravitee.io/ingress"
 	KeyPairFinalizer                 = "finalizers.gravitee.io/keypair"
 	ApplicationFinalizer             = "finalizers.gravitee.io/applicationdeletion"
+	GroupFinalizer                   = "finalizers.gravitee.io/groupdeletion"
+	CategoryFinalizer                = "finalizers.gravitee.io/categorydeletion"
+	DictionaryFinalizer              = "finalizers.gravitee.io/dictionarydeletion"
+	SharedPolicyGroupFinalizer       = "finalizers.gravitee.io/sharedpolicygroupdeletion"
+	TenantFinalizer                  = "finalizers.gravitee.io/tenantdeletion"
+	ShardingTagFinalizer             = "finalizers.gravitee.io/shardingtagdeletion"
+	RoleFinalizer                    = "finalizers.gravitee.io/roledeletion"
+	IdentityProviderFinalizer        = "finalizers.gravitee.io/identityproviderdeletion"
+	PortalThemeFinalizer             = "finalizers.gravitee.io/portalthemedeletion"
+	PortalPageFinalizer              = "finalizers.gravitee.io/portalpagedeletion"
 	TemplatingFinalizer              = "finalizers.gravitee.io/templating"
 
 	CloudTokenSecretKey  = "cloudToken"
@@ -0,0 +1,72 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dictionary
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/dictionary"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+)
+
+// ResolveProperties merges the entries of the config maps and secrets referenced by a manual dictionary
+// with its inline properties. Dynamic dictionaries get their properties from their provider.
+func ResolveProperties(ctx context.Context, dict *v1alpha1.Dictionary) (map[string]string, error) {
+	if dict.Spec.IsDynamic() {
+		return nil, nil
+	}
+
+	properties := make(map[string]string)
+	for _, source := range dict.Spec.PropertiesFrom {
+		entries, err := resolveSource(ctx, source, dict.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range entries {
+			properties[key] = value
+		}
+	}
+
+	for key, value := range dict.Spec.Properties {
+		properties[key] = value
+	}
+
+	return properties, nil
+}
+
+func resolveSource(
+	ctx context.Context,
+	source *dictionary.PropertiesSource,
+	namespace string,
+) (map[string]string, error) {
+	switch {
+	case source.ConfigMap != nil:
+		entries, err := k8s.ResolveConfigMapEntries(ctx, source.ConfigMap, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read dictionary properties from config map [%s]: %w", source.ConfigMap, err)
+		}
+		return entries, nil
+	case source.Secret != nil:
+		entries, err := k8s.ResolveSecretEntries(ctx, source.Secret, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read dictionary properties from secret [%s]: %w", source.Secret, err)
+		}
+		return entries, nil
+	default:
+		return nil, fmt.Errorf("either a config map or a secret reference is required")
+	}
+}
@@ -15,9 +15,13 @@
 package env
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
 )
@@ -27,6 +31,7 @@ const (
 	CMTemplate404NS                      = "TEMPLATE_404_CONFIG_MAP_NAMESPACE"
 	Development                          = "DEV_MODE"
 	NS                                   = "NAMESPACE"
+	OperatorNS                           = "OPERATOR_NAMESPACE"
 	ApplyCRDs                            = "APPLY_CRDS"
 	EnableMetrics                        = "ENABLE_METRICS"
 	EnableWebhook                        = "ENABLE_WEBHOOK"
@@ -39,14 +44,24 @@ const (
 	TrueString                           = "true"
 	IngressClasses                       = "INGRESS_CLASSES"
 	CheckApiContextPathConflictInCluster = "CHECK_API_CONTEXT_PATH_CONFLICT_IN_CLUSTER"
+	CertificateExpiryThresholds          = "CERTIFICATE_EXPIRY_THRESHOLDS"
+	MembersValidation                    = "MEMBERS_VALIDATION"
+	MembersProvisioningSources           = "MEMBERS_PROVISIONING_SOURCES"
+
+	// Values accepted by the members validation setting.
+	MembersValidationOff    = "off"
+	MembersValidationWarn   = "warn"
+	MembersValidationReject = "reject"
 
 	// This default are applied when running the app locally.
 	defaultWebhookPort       = 9443
 	defaultHttpClientTimeout = 5
+	defaultExpiryThresholds  = "720h,168h,24h"
 )
 
 var Config = struct {
 	NS                                   string
+	OperatorNS                           string
 	ApplyCRDs                            bool
 	EnableMetrics                        bool
 	EnableWebhook                        bool
@@ -61,10 +76,14 @@ var Config = struct {
 	HTTPClientTimeoutSeconds             int
 	IngressClasses                       []string
 	CheckApiContextPathConflictInCluster bool
+	CertificateExpiryThresholds          []time.Duration
+	MembersValidation                    string
+	MembersProvisioningSources           []string
 }{}
 
 func init() {
 	Config.NS = os.Getenv(NS)
+	Config.OperatorNS = os.Getenv(OperatorNS)
 	Config.ApplyCRDs = os.Getenv(ApplyCRDs) == TrueString
 	Config.Development = os.Getenv(Development) == TrueString
 	Config.CMTemplate404Name = os.Getenv(CMTemplate404Name)
@@ -78,6 +97,9 @@ func init() {
 	Config.WebhookCertSecret = os.Getenv(WebhookCertSecret)
 	Config.WebhookPort = parseInt(WebhookPort, defaultWebhookPort)
 	Config.CheckApiContextPathConflictInCluster = os.Getenv(CheckApiContextPathConflictInCluster) == TrueString
+	Config.CertificateExpiryThresholds = parseDurations(CertificateExpiryThresholds, defaultExpiryThresholds)
+	Config.MembersValidation = parseMembersValidation(MembersValidation)
+	Config.MembersProvisioningSources = parseList(MembersProvisioningSources)
 	var ingressClass string
 	if ingressClass = core.IngressClassAnnotationValue; os.Getenv(IngressClasses) != "" {
 		ingressClass = os.Getenv(IngressClasses)
@@ -85,6 +107,87 @@ func init() {
 	Config.IngressClasses = strings.Split(ingressClass, ",")
 }
 
+// configErrors holds the invalid values found while reading the environment.
+var configErrors []error
+
+// Validate returns an error if some of the values read from the environment are invalid.
+func Validate() error {
+	return errors.Join(configErrors...)
+}
+
+func parseDurations(key string, defaultValue string) []time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		value = defaultValue
+	}
+
+	durations, err := ParseDurations(value)
+	if err != nil {
+		configErrors = append(configErrors, fmt.Errorf("invalid value for %s: %w", key, err))
+	}
+
+	return durations
+}
+
+// ParseDurations parses a comma separated list of positive durations,
+// sorted from the longest to the shortest.
+func ParseDurations(value string) ([]time.Duration, error) {
+	durations := make([]time.Duration, 0)
+	for _, s := range strings.Split(value, ",") {
+		d, err := time.ParseDuration(strings.TrimSpace(s))
+		if err != nil {
+			return nil, err
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("duration %s must be positive", d)
+		}
+		durations = append(durations, d)
+	}
+
+	sort.Slice(durations, func(i, j int) bool {
+		return durations[i] > durations[j]
+	})
+
+	return durations, nil
+}
+
+// IsClusterScoped returns true if the operator is not scoped to a single namespace.
+// Cluster scoped resources are only watched in that case.
+func IsClusterScoped() bool {
+	return Config.NS == ""
+}
+
+// IsProvisioningSource returns true if users of the given identity source should be
+// created in APIM when referenced as members but not found.
+func IsProvisioningSource(source string) bool {
+	for _, s := range Config.MembersProvisioningSources {
+		if strings.EqualFold(s, source) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseMembersValidation(key string) string {
+	switch value := strings.ToLower(os.Getenv(key)); value {
+	case MembersValidationOff, MembersValidationReject:
+		return value
+	default:
+		return MembersValidationWarn
+	}
+}
+
+// parseList parses a comma separated list of values, ignoring blank entries.
+func parseList(key string) []string {
+	values := make([]string, 0)
+	for _, s := range strings.Split(os.Getenv(key), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
 func parseInt(key string, defaultValue int) int {
 	value, err := strconv.Atoi(os.Getenv(key))
 	if err != nil {
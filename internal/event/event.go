@@ -94,3 +94,8 @@ func (e *Recorder) info(obj runtime.Object, reason string, message string) {
 func (e *Recorder) warn(obj runtime.Object, reason string, message string) {
 	e.k8sEventRecorder.Event(obj, string(Warning), reason, message)
 }
+
+// Warn records a warning event that is not tied to an action.
+func (e *Recorder) Warn(obj runtime.Object, reason string, message string) {
+	e.warn(obj, reason, message)
+}
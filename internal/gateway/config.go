@@ -24,7 +24,9 @@ import (
 
 const (
 	graviteeKubeScheme = "kubernetes://"
-	jksKeystoreType    = "jks"
+
+	JKSKeystoreType    = "jks"
+	PKCS12KeystoreType = "pkcs12"
 
 	expectedKubeFormat             = "$NS/(secrets|configmaps)/$NAME/$KEY"
 	expectedKubePathComponentCount = 4
@@ -57,8 +59,8 @@ type KeystoreConfig struct {
 }
 
 func (gkc KeystoreConfig) Validate() error {
-	if strings.ToLower(gkc.Type) != jksKeystoreType {
-		return fmt.Errorf("expected keystore type jks, got %s", gkc.Type)
+	if t := strings.ToLower(gkc.Type); t != JKSKeystoreType && t != PKCS12KeystoreType {
+		return fmt.Errorf("expected keystore type jks or pkcs12, got %s", gkc.Type)
 	}
 
 	if !gkc.Location.IsValid() {
@@ -134,6 +136,15 @@ func (gkp GraviteeKubeProperty) Key() string {
 	return strings.Split(gkp.TrimPrefix(), "/")[3]
 }
 
+// NewGraviteeKubeProperty returns the location of a key in a secret or a config map.
+func NewGraviteeKubeProperty(propertyType, ns, name, key string) GraviteeKubeProperty {
+	return GraviteeKubeProperty(graviteeKubeScheme + strings.Join([]string{ns, propertyType, name, key}, "/"))
+}
+
+func (gkp GraviteeKubeProperty) IsKubeProperty() bool {
+	return strings.HasPrefix(string(gkp), graviteeKubeScheme)
+}
+
 func (gkp GraviteeKubeProperty) String() string {
 	return string(gkp)
 }
@@ -16,9 +16,13 @@ package indexer
 
 import (
 	"context"
+	"slices"
 
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
 	v1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/util/errors"
@@ -37,6 +41,50 @@ const (
 	ApiTemplateField   IndexField = "api-template"
 	TLSSecretField     IndexField = "tls-secret"
 	AppContextField    IndexField = "app-context"
+	IngressClassField  IndexField = "ingress-class"
+	IngressHostField   IndexField = "ingress-host"
+	CanaryPathField    IndexField = "canary-path"
+	GroupContextField  IndexField = "group-context"
+	ApiGroupField      IndexField = "api-group"
+	ApiV4GroupField    IndexField = "api-v4-group"
+	AppGroupField      IndexField = "app-group"
+
+	CategoryContextField IndexField = "category-context"
+	ApiCategoryField     IndexField = "api-category"
+	ApiV4CategoryField   IndexField = "api-v4-category"
+
+	DictionaryContextField   IndexField = "dictionary-context"
+	DictionaryConfigMapField IndexField = "dictionary-config-map"
+	DictionarySecretField    IndexField = "dictionary-secret"
+
+	SharedPolicyGroupContextField IndexField = "shared-policy-group-context"
+	ApiV4SharedPolicyGroupField   IndexField = "api-v4-shared-policy-group"
+
+	ApiPageConfigMapField   IndexField = "api-page-config-map"
+	ApiPageSecretField      IndexField = "api-page-secret"
+	ApiV4PageConfigMapField IndexField = "api-v4-page-config-map"
+	ApiV4PageSecretField    IndexField = "api-v4-page-secret"
+
+	TenantContextField      IndexField = "tenant-context"
+	ShardingTagContextField IndexField = "sharding-tag-context"
+	RoleContextField        IndexField = "role-context"
+
+	IdentityProviderContextField   IndexField = "identity-provider-context"
+	IdentityProviderConfigMapField IndexField = "identity-provider-config-map"
+	IdentityProviderSecretField    IndexField = "identity-provider-secret"
+
+	PortalThemeContextField   IndexField = "portal-theme-context"
+	PortalThemeConfigMapField IndexField = "portal-theme-config-map"
+	PortalThemeSecretField    IndexField = "portal-theme-secret"
+
+	PortalPageContextField   IndexField = "portal-page-context"
+	PortalPageConfigMapField IndexField = "portal-page-config-map"
+	PortalPageSecretField    IndexField = "portal-page-secret"
+	PortalPageParentField    IndexField = "portal-page-parent"
+
+	IngressClassParametersField IndexField = "ingress-class-parameters"
+
+	ClusterContextSecretField IndexField = "cluster-context-secret"
 )
 
 func (f IndexField) String() string {
@@ -85,6 +133,19 @@ func InitCache(ctx context.Context, cache cache.Cache) error {
 		errs = append(errs, err)
 	}
 
+	// cluster management contexts can only be read when the operator is cluster scoped
+	if env.IsClusterScoped() {
+		clusterSecretIndexer := newIndexer(ClusterContextSecretField, indexClusterContextSecrets)
+		if err := cache.IndexField(
+			ctx,
+			&v1alpha1.ClusterManagementContext{},
+			clusterSecretIndexer.Field,
+			clusterSecretIndexer.Func,
+		); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	apiTemplateIndexer := newIndexer(ApiTemplateField, indexApiTemplate)
 	if err := cache.IndexField(ctx, &v1.Ingress{}, apiTemplateIndexer.Field, apiTemplateIndexer.Func); err != nil {
 		errs = append(errs, err)
@@ -95,11 +156,203 @@ func InitCache(ctx context.Context, cache cache.Cache) error {
 		errs = append(errs, err)
 	}
 
+	ingressClassIndexer := newIndexer(IngressClassField, indexIngressClass)
+	if err := cache.IndexField(ctx, &v1.Ingress{}, ingressClassIndexer.Field, ingressClassIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	ingressHostIndexer := newIndexer(IngressHostField, indexIngressHosts)
+	if err := cache.IndexField(ctx, &v1.Ingress{}, ingressHostIndexer.Field, ingressHostIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	canaryPathIndexer := newIndexer(CanaryPathField, indexCanaryPaths)
+	if err := cache.IndexField(ctx, &v1.Ingress{}, canaryPathIndexer.Field, canaryPathIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	ingressClassParametersIndexer := newIndexer(IngressClassParametersField, indexIngressClassParameters)
+	if err := cache.IndexField(ctx, &v1.IngressClass{}, ingressClassParametersIndexer.Field,
+		ingressClassParametersIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
 	appContextIndexer := newIndexer(AppContextField, indexApplicationManagementContexts)
 	if err := cache.IndexField(ctx, &v1alpha1.Application{}, appContextIndexer.Field, appContextIndexer.Func); err != nil {
 		errs = append(errs, err)
 	}
 
+	groupContextIndexer := newIndexer(GroupContextField, indexGroupManagementContexts)
+	if err := cache.IndexField(ctx, &v1alpha1.Group{}, groupContextIndexer.Field, groupContextIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	apiGroupIndexer := newIndexer(ApiGroupField, indexApiGroups)
+	if err := cache.IndexField(ctx, &v1alpha1.ApiDefinition{}, apiGroupIndexer.Field, apiGroupIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	apiV4GroupIndexer := newIndexer(ApiV4GroupField, indexApiV4Groups)
+	if err := cache.IndexField(ctx, &v1alpha1.ApiV4Definition{}, apiV4GroupIndexer.Field,
+		apiV4GroupIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	appGroupIndexer := newIndexer(AppGroupField, indexApplicationGroups)
+	if err := cache.IndexField(ctx, &v1alpha1.Application{}, appGroupIndexer.Field, appGroupIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	categoryContextIndexer := newIndexer(CategoryContextField, indexCategoryManagementContexts)
+	if err := cache.IndexField(ctx, &v1alpha1.Category{}, categoryContextIndexer.Field,
+		categoryContextIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	apiCategoryIndexer := newIndexer(ApiCategoryField, indexApiCategories)
+	if err := cache.IndexField(ctx, &v1alpha1.ApiDefinition{}, apiCategoryIndexer.Field,
+		apiCategoryIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	apiV4CategoryIndexer := newIndexer(ApiV4CategoryField, indexApiV4Categories)
+	if err := cache.IndexField(ctx, &v1alpha1.ApiV4Definition{}, apiV4CategoryIndexer.Field,
+		apiV4CategoryIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	dictionaryContextIndexer := newIndexer(DictionaryContextField, indexDictionaryManagementContexts)
+	if err := cache.IndexField(ctx, &v1alpha1.Dictionary{}, dictionaryContextIndexer.Field,
+		dictionaryContextIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	dictionaryConfigMapIndexer := newIndexer(DictionaryConfigMapField, indexDictionaryConfigMaps)
+	if err := cache.IndexField(ctx, &v1alpha1.Dictionary{}, dictionaryConfigMapIndexer.Field,
+		dictionaryConfigMapIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	dictionarySecretIndexer := newIndexer(DictionarySecretField, indexDictionarySecrets)
+	if err := cache.IndexField(ctx, &v1alpha1.Dictionary{}, dictionarySecretIndexer.Field,
+		dictionarySecretIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	sharedPolicyGroupContextIndexer := newIndexer(SharedPolicyGroupContextField, indexSharedPolicyGroupManagementContexts)
+	if err := cache.IndexField(ctx, &v1alpha1.SharedPolicyGroup{}, sharedPolicyGroupContextIndexer.Field,
+		sharedPolicyGroupContextIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	apiV4SharedPolicyGroupIndexer := newIndexer(ApiV4SharedPolicyGroupField, indexApiV4SharedPolicyGroups)
+	if err := cache.IndexField(ctx, &v1alpha1.ApiV4Definition{}, apiV4SharedPolicyGroupIndexer.Field,
+		apiV4SharedPolicyGroupIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	apiPageConfigMapIndexer := newIndexer(ApiPageConfigMapField, indexApiPageConfigMaps)
+	if err := cache.IndexField(ctx, &v1alpha1.ApiDefinition{}, apiPageConfigMapIndexer.Field,
+		apiPageConfigMapIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	apiPageSecretIndexer := newIndexer(ApiPageSecretField, indexApiPageSecrets)
+	if err := cache.IndexField(ctx, &v1alpha1.ApiDefinition{}, apiPageSecretIndexer.Field,
+		apiPageSecretIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	apiV4PageConfigMapIndexer := newIndexer(ApiV4PageConfigMapField, indexApiV4PageConfigMaps)
+	if err := cache.IndexField(ctx, &v1alpha1.ApiV4Definition{}, apiV4PageConfigMapIndexer.Field,
+		apiV4PageConfigMapIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	apiV4PageSecretIndexer := newIndexer(ApiV4PageSecretField, indexApiV4PageSecrets)
+	if err := cache.IndexField(ctx, &v1alpha1.ApiV4Definition{}, apiV4PageSecretIndexer.Field,
+		apiV4PageSecretIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	tenantContextIndexer := newIndexer(TenantContextField, indexTenantManagementContexts)
+	if err := cache.IndexField(ctx, &v1alpha1.Tenant{}, tenantContextIndexer.Field,
+		tenantContextIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	shardingTagContextIndexer := newIndexer(ShardingTagContextField, indexShardingTagManagementContexts)
+	if err := cache.IndexField(ctx, &v1alpha1.ShardingTag{}, shardingTagContextIndexer.Field,
+		shardingTagContextIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	roleContextIndexer := newIndexer(RoleContextField, indexRoleManagementContexts)
+	if err := cache.IndexField(ctx, &v1alpha1.Role{}, roleContextIndexer.Field,
+		roleContextIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	idpContextIndexer := newIndexer(IdentityProviderContextField, indexIdentityProviderManagementContexts)
+	if err := cache.IndexField(ctx, &v1alpha1.IdentityProvider{}, idpContextIndexer.Field,
+		idpContextIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	idpConfigMapIndexer := newIndexer(IdentityProviderConfigMapField, indexIdentityProviderConfigMaps)
+	if err := cache.IndexField(ctx, &v1alpha1.IdentityProvider{}, idpConfigMapIndexer.Field,
+		idpConfigMapIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	idpSecretIndexer := newIndexer(IdentityProviderSecretField, indexIdentityProviderSecrets)
+	if err := cache.IndexField(ctx, &v1alpha1.IdentityProvider{}, idpSecretIndexer.Field,
+		idpSecretIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	themeContextIndexer := newIndexer(PortalThemeContextField, indexPortalThemeManagementContexts)
+	if err := cache.IndexField(ctx, &v1alpha1.PortalTheme{}, themeContextIndexer.Field,
+		themeContextIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	themeConfigMapIndexer := newIndexer(PortalThemeConfigMapField, indexPortalThemeConfigMaps)
+	if err := cache.IndexField(ctx, &v1alpha1.PortalTheme{}, themeConfigMapIndexer.Field,
+		themeConfigMapIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	themeSecretIndexer := newIndexer(PortalThemeSecretField, indexPortalThemeSecrets)
+	if err := cache.IndexField(ctx, &v1alpha1.PortalTheme{}, themeSecretIndexer.Field,
+		themeSecretIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	pageContextIndexer := newIndexer(PortalPageContextField, indexPortalPageManagementContexts)
+	if err := cache.IndexField(ctx, &v1alpha1.PortalPage{}, pageContextIndexer.Field,
+		pageContextIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	pageConfigMapIndexer := newIndexer(PortalPageConfigMapField, indexPortalPageConfigMaps)
+	if err := cache.IndexField(ctx, &v1alpha1.PortalPage{}, pageConfigMapIndexer.Field,
+		pageConfigMapIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	pageSecretIndexer := newIndexer(PortalPageSecretField, indexPortalPageSecrets)
+	if err := cache.IndexField(ctx, &v1alpha1.PortalPage{}, pageSecretIndexer.Field,
+		pageSecretIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
+	pageParentIndexer := newIndexer(PortalPageParentField, indexPortalPageParents)
+	if err := cache.IndexField(ctx, &v1alpha1.PortalPage{}, pageParentIndexer.Field,
+		pageParentIndexer.Func); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errors.NewAggregate(errs)
 }
 
@@ -134,11 +387,17 @@ func indexManagementContexts(api *v1alpha1.ApiDefinition, fields *[]string) {
 }
 
 func indexApiV4ManagementContexts(api *v1alpha1.ApiV4Definition, fields *[]string) {
-	if api.Spec.Context == nil {
-		return
+	if api.Spec.Context != nil {
+		*fields = append(*fields, api.Spec.Context.String())
 	}
 
-	*fields = append(*fields, api.Spec.Context.String())
+	for i := range api.Spec.Contexts {
+		ref := api.Spec.Contexts[i].NamespacedName
+		if ref.IsMissingNamespace() {
+			ref.SetNamespace(api.Namespace)
+		}
+		*fields = append(*fields, ref.String())
+	}
 }
 
 func indexManagementContextSecrets(context *v1alpha1.ManagementContext, fields *[]string) {
@@ -147,6 +406,14 @@ func indexManagementContextSecrets(context *v1alpha1.ManagementContext, fields *
 	}
 }
 
+// Secrets of cluster management contexts are always read from the namespace of the operator.
+func indexClusterContextSecrets(context *v1alpha1.ClusterManagementContext, fields *[]string) {
+	if context.HasSecretRef() {
+		secret := refs.NewNamespacedName(env.Config.OperatorNS, context.Spec.Auth.SecretRef.Name)
+		*fields = append(*fields, secret.String())
+	}
+}
+
 func indexApiResourceRefs(api *v1alpha1.ApiDefinition, fields *[]string) {
 	if api.Spec.Resources == nil {
 		return
@@ -179,8 +446,10 @@ func indexApiTemplate(ing *v1.Ingress, fields *[]string) {
 	*fields = append(*fields, ing.Namespace+"/"+ing.Annotations[core.IngressTemplateAnnotation])
 }
 
+// Ingresses are indexed whatever their class, as resolving parameterized classes requires a client read.
+// Ingresses that are not handled by the operator are filtered out when looking up the index.
 func indexTLSSecret(ing *v1.Ingress, fields *[]string) {
-	if !k8s.IsGraviteeIngress(ing) {
+	if k8s.GetIngressClassName(ing) == "" {
 		return
 	}
 
@@ -193,6 +462,59 @@ func indexTLSSecret(ing *v1.Ingress, fields *[]string) {
 	}
 }
 
+func indexIngressClass(ing *v1.Ingress, fields *[]string) {
+	ingressClassName := k8s.GetIngressClassName(ing)
+	if ingressClassName == "" {
+		return
+	}
+
+	ref := refs.NewNamespacedName("", ingressClassName)
+	*fields = append(*fields, ref.String())
+}
+
+// Ingresses that are not canaries are indexed by the hosts of their rules,
+// so that they can be reconciled when a canary ingress for one of these hosts changes.
+func indexIngressHosts(ing *v1.Ingress, fields *[]string) {
+	if k8s.GetIngressClassName(ing) == "" || k8s.IsCanaryIngress(ing) {
+		return
+	}
+
+	for _, rule := range ing.Spec.Rules {
+		*fields = append(*fields, ing.Namespace+"/"+rule.Host)
+	}
+}
+
+// Canary ingresses are indexed by the hosts and paths of their rules,
+// so that the ingresses exposing the same hosts and paths can look them up.
+func indexCanaryPaths(ing *v1.Ingress, fields *[]string) {
+	if k8s.GetIngressClassName(ing) == "" || !k8s.IsCanaryIngress(ing) {
+		return
+	}
+
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			*fields = append(*fields, ing.Namespace+"/"+rule.Host+path.Path)
+		}
+	}
+}
+
+func indexIngressClassParameters(ingressClass *v1.IngressClass, fields *[]string) {
+	params := ingressClass.Spec.Parameters
+	if params == nil || params.Kind != core.CRDIngressClassParametersKind {
+		return
+	}
+
+	if params.Namespace == nil {
+		return
+	}
+
+	ref := refs.NewNamespacedName(*params.Namespace, params.Name)
+	*fields = append(*fields, ref.String())
+}
+
 func indexApplicationManagementContexts(application *v1alpha1.Application, fields *[]string) {
 	if application.Spec.Context == nil {
 		return
@@ -200,3 +522,286 @@ func indexApplicationManagementContexts(application *v1alpha1.Application, field
 
 	*fields = append(*fields, application.Spec.Context.String())
 }
+
+func indexGroupManagementContexts(group *v1alpha1.Group, fields *[]string) {
+	if group.Spec.Context == nil {
+		return
+	}
+
+	*fields = append(*fields, group.Spec.Context.String())
+}
+
+// APIs and applications reference groups by their name in APIM. They are indexed by the
+// names of the groups they reference, prefixed by their namespace, so that they can be
+// reconciled when a group of the same namespace becomes available.
+func indexApiGroups(api *v1alpha1.ApiDefinition, fields *[]string) {
+	if api.Spec.ApiBase == nil {
+		return
+	}
+
+	indexNamespacedNames(api.Namespace, api.Spec.Groups, fields)
+}
+
+func indexApiV4Groups(api *v1alpha1.ApiV4Definition, fields *[]string) {
+	if api.Spec.ApiBase != nil {
+		indexNamespacedNames(api.Namespace, api.Spec.Groups, fields)
+	}
+
+	for _, plan := range api.Spec.Plans {
+		if plan != nil {
+			indexNamespacedNames(api.Namespace, plan.ExcludedGroups, fields)
+		}
+	}
+}
+
+func indexApplicationGroups(application *v1alpha1.Application, fields *[]string) {
+	indexNamespacedNames(application.Namespace, application.Spec.Groups, fields)
+}
+
+func indexNamespacedNames(namespace string, names []string, fields *[]string) {
+	for _, name := range names {
+		ref := refs.NewNamespacedName(namespace, name)
+		if !slices.Contains(*fields, ref.String()) {
+			*fields = append(*fields, ref.String())
+		}
+	}
+}
+
+func indexCategoryManagementContexts(category *v1alpha1.Category, fields *[]string) {
+	if category.Spec.Context == nil {
+		return
+	}
+
+	*fields = append(*fields, category.Spec.Context.String())
+}
+
+// APIs reference categories by their key or name in APIM. Like groups, they are indexed
+// by these references prefixed by their namespace.
+func indexApiCategories(api *v1alpha1.ApiDefinition, fields *[]string) {
+	if api.Spec.ApiBase != nil {
+		indexNamespacedNames(api.Namespace, api.Spec.Categories, fields)
+	}
+}
+
+func indexApiV4Categories(api *v1alpha1.ApiV4Definition, fields *[]string) {
+	if api.Spec.ApiBase != nil {
+		indexNamespacedNames(api.Namespace, api.Spec.Categories, fields)
+	}
+}
+
+func indexDictionaryManagementContexts(dictionary *v1alpha1.Dictionary, fields *[]string) {
+	if dictionary.Spec.Context == nil {
+		return
+	}
+
+	*fields = append(*fields, dictionary.Spec.Context.String())
+}
+
+func indexDictionaryConfigMaps(dictionary *v1alpha1.Dictionary, fields *[]string) {
+	for _, source := range dictionary.Spec.PropertiesFrom {
+		if source.ConfigMap != nil {
+			indexNamespacedNames(dictionary.Namespace, []string{source.ConfigMap.Name}, fields)
+		}
+	}
+}
+
+func indexDictionarySecrets(dictionary *v1alpha1.Dictionary, fields *[]string) {
+	for _, source := range dictionary.Spec.PropertiesFrom {
+		if source.Secret != nil {
+			indexNamespacedNames(dictionary.Namespace, []string{source.Secret.Name}, fields)
+		}
+	}
+}
+
+func indexSharedPolicyGroupManagementContexts(group *v1alpha1.SharedPolicyGroup, fields *[]string) {
+	if group.Spec.Context == nil {
+		return
+	}
+
+	*fields = append(*fields, group.Spec.Context.String())
+}
+
+func indexApiV4SharedPolicyGroups(api *v1alpha1.ApiV4Definition, fields *[]string) {
+	for _, flow := range api.Spec.GetAllFlows() {
+		if flow == nil {
+			continue
+		}
+		for _, steps := range flow.StepsByPhase() {
+			for _, step := range steps {
+				if step != nil && step.HasSharedPolicyGroupRef() {
+					indexReference(api.Namespace, step.SharedPolicyGroup, fields)
+				}
+			}
+		}
+	}
+}
+
+func indexApiPageConfigMaps(api *v1alpha1.ApiDefinition, fields *[]string) {
+	for _, page := range api.Spec.Pages {
+		if page != nil {
+			indexPageConfigMaps(api.Namespace, page.Page, fields)
+		}
+	}
+}
+
+func indexApiPageSecrets(api *v1alpha1.ApiDefinition, fields *[]string) {
+	for _, page := range api.Spec.Pages {
+		if page != nil {
+			indexPageSecrets(api.Namespace, page.Page, fields)
+		}
+	}
+}
+
+// The OpenAPI specification of a v4 API is indexed along with its pages as it ends up in a SWAGGER page.
+func indexApiV4PageConfigMaps(api *v1alpha1.ApiV4Definition, fields *[]string) {
+	for _, page := range api.Spec.Pages {
+		if page != nil {
+			indexPageConfigMaps(api.Namespace, page.Page, fields)
+		}
+	}
+	if oas := api.Spec.OpenAPI; oas != nil && oas.From != nil && oas.From.ConfigMapKeyRef != nil {
+		indexKeyRef(api.Namespace, oas.From.ConfigMapKeyRef, fields)
+	}
+}
+
+func indexApiV4PageSecrets(api *v1alpha1.ApiV4Definition, fields *[]string) {
+	for _, page := range api.Spec.Pages {
+		if page != nil {
+			indexPageSecrets(api.Namespace, page.Page, fields)
+		}
+	}
+	if oas := api.Spec.OpenAPI; oas != nil && oas.From != nil && oas.From.SecretKeyRef != nil {
+		indexKeyRef(api.Namespace, oas.From.SecretKeyRef, fields)
+	}
+}
+
+func indexPageConfigMaps(namespace string, page *base.Page, fields *[]string) {
+	if page == nil {
+		return
+	}
+	if page.ContentFrom != nil && page.ContentFrom.ConfigMapKeyRef != nil {
+		indexKeyRef(namespace, page.ContentFrom.ConfigMapKeyRef, fields)
+	}
+	if page.PagesFrom != nil {
+		indexNamespacedNames(namespace, []string{page.PagesFrom.Name}, fields)
+	}
+}
+
+func indexPageSecrets(namespace string, page *base.Page, fields *[]string) {
+	if page != nil && page.ContentFrom != nil && page.ContentFrom.SecretKeyRef != nil {
+		indexKeyRef(namespace, page.ContentFrom.SecretKeyRef, fields)
+	}
+}
+
+func indexTenantManagementContexts(tenant *v1alpha1.Tenant, fields *[]string) {
+	if tenant.Spec.Context == nil {
+		return
+	}
+
+	*fields = append(*fields, tenant.Spec.Context.String())
+}
+
+func indexShardingTagManagementContexts(tag *v1alpha1.ShardingTag, fields *[]string) {
+	if tag.Spec.Context == nil {
+		return
+	}
+
+	*fields = append(*fields, tag.Spec.Context.String())
+}
+
+func indexRoleManagementContexts(role *v1alpha1.Role, fields *[]string) {
+	if role.Spec.Context == nil {
+		return
+	}
+
+	*fields = append(*fields, role.Spec.Context.String())
+}
+
+func indexIdentityProviderManagementContexts(idp *v1alpha1.IdentityProvider, fields *[]string) {
+	if idp.Spec.Context == nil {
+		return
+	}
+
+	*fields = append(*fields, idp.Spec.Context.String())
+}
+
+func indexIdentityProviderConfigMaps(idp *v1alpha1.IdentityProvider, fields *[]string) {
+	for _, from := range idp.Spec.ConfigurationFrom {
+		if from != nil && from.ConfigMapKeyRef != nil {
+			indexKeyRef(idp.Namespace, from.ConfigMapKeyRef, fields)
+		}
+	}
+}
+
+func indexIdentityProviderSecrets(idp *v1alpha1.IdentityProvider, fields *[]string) {
+	for _, from := range idp.Spec.ConfigurationFrom {
+		if from != nil && from.SecretKeyRef != nil {
+			indexKeyRef(idp.Namespace, from.SecretKeyRef, fields)
+		}
+	}
+}
+
+func indexPortalThemeManagementContexts(theme *v1alpha1.PortalTheme, fields *[]string) {
+	if theme.Spec.Context == nil {
+		return
+	}
+
+	*fields = append(*fields, theme.Spec.Context.String())
+}
+
+func indexPortalThemeConfigMaps(theme *v1alpha1.PortalTheme, fields *[]string) {
+	for _, from := range theme.Spec.Sources() {
+		if from.ConfigMapKeyRef != nil {
+			indexKeyRef(theme.Namespace, from.ConfigMapKeyRef, fields)
+		}
+	}
+}
+
+func indexPortalThemeSecrets(theme *v1alpha1.PortalTheme, fields *[]string) {
+	for _, from := range theme.Spec.Sources() {
+		if from.SecretKeyRef != nil {
+			indexKeyRef(theme.Namespace, from.SecretKeyRef, fields)
+		}
+	}
+}
+
+func indexPortalPageManagementContexts(page *v1alpha1.PortalPage, fields *[]string) {
+	if page.Spec.Context == nil {
+		return
+	}
+
+	*fields = append(*fields, page.Spec.Context.String())
+}
+
+func indexPortalPageConfigMaps(page *v1alpha1.PortalPage, fields *[]string) {
+	if from := page.Spec.ContentFrom; from != nil && from.ConfigMapKeyRef != nil {
+		indexKeyRef(page.Namespace, from.ConfigMapKeyRef, fields)
+	}
+}
+
+func indexPortalPageSecrets(page *v1alpha1.PortalPage, fields *[]string) {
+	if from := page.Spec.ContentFrom; from != nil && from.SecretKeyRef != nil {
+		indexKeyRef(page.Namespace, from.SecretKeyRef, fields)
+	}
+}
+
+func indexPortalPageParents(page *v1alpha1.PortalPage, fields *[]string) {
+	if page.Spec.ParentRef != nil {
+		indexReference(page.Namespace, page.Spec.ParentRef, fields)
+	}
+}
+
+// indexReference indexes a reference by its namespaced name,
+// references without a namespace being resolved in the given namespace.
+func indexReference(namespace string, ref *refs.NamespacedName, fields *[]string) {
+	if ref.HasNameSpace() {
+		namespace = ref.Namespace
+	}
+	indexNamespacedNames(namespace, []string{ref.Name}, fields)
+}
+
+// indexKeyRef indexes the config map or secret holding a key by its name
+// prefixed by the namespace of the referencing resource.
+func indexKeyRef(namespace string, ref *refs.KeyRef, fields *[]string) {
+	indexNamespacedNames(namespace, []string{ref.Name}, fields)
+}
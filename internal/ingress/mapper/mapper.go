@@ -47,7 +47,10 @@ const (
 	mockHeadersKey         = "headers"
 	endpointNamePattern    = "rule%02d-path%02d"
 	endpointMatcherPattern = "%s:{#group[0]}"
+	stableEndpointPattern  = "%s-stable"
+	canaryEndpointPattern  = "%s-canary"
 	rootPath               = "/"
+	maxWeight              = 100
 )
 
 var hostCondition = el.Expression("#request.host == '%s'")
@@ -57,13 +60,22 @@ var pathCondition = el.Expression("#request.contextPath.startsWith('%s')")
 type Mapper struct {
 	opts       Opts
 	hosts      map[string]bool
+	canaries   map[string]*canaryBackend
 	conditions []el.Expression
 }
 
+// The backend of a canary ingress for a given host and path.
+type canaryBackend struct {
+	*v1.IngressServiceBackend
+	namespace string
+	weight    int
+}
+
 func New(opts Opts) *Mapper {
 	return &Mapper{
 		opts:       mergeOpts(opts),
 		hosts:      make(map[string]bool),
+		canaries:   make(map[string]*canaryBackend),
 		conditions: make([]el.Expression, 0),
 	}
 }
@@ -97,16 +109,36 @@ func newIndexedPath(path *v1.HTTPIngressPath, ruleIndex, index int) *indexedPath
 // a 404 response is returned by a flow that negates all the previous conditions.
 func (m *Mapper) Map(apiDefinition *v1alpha1.ApiDefinition, ingress *v1.Ingress) *v1alpha1.ApiDefinition {
 	m.hosts = getHosts(ingress)
+	m.canaries = getCanaryBackends(m.opts.Canaries)
 	cp := buildApiCopy(apiDefinition, ingress)
-	cp.Spec.Proxy = buildProxy(ingress)
+	cp.Spec.Proxy = m.buildProxy(ingress)
 	cp.Spec.Flows = m.buildFlows(ingress.Spec.Rules)
 	if apiDefinition.Spec.Flows != nil {
 		cp.Spec.FlowMode = v2.DefaultFlowMode
 		cp.Spec.Flows = append(cp.Spec.Flows, apiDefinition.Spec.Flows...)
 	}
+	m.addResponseTemplates(cp)
 	return cp
 }
 
+// Add the response templates provided by the ingress class parameters,
+// keeping the ones already defined by the API template.
+func (m *Mapper) addResponseTemplates(api *v1alpha1.ApiDefinition) {
+	if len(m.opts.ResponseTemplates) == 0 {
+		return
+	}
+
+	if api.Spec.ResponseTemplates == nil {
+		api.Spec.ResponseTemplates = make(map[string]map[string]*base.ResponseTemplate)
+	}
+
+	for key, templates := range m.opts.ResponseTemplates {
+		if _, ok := api.Spec.ResponseTemplates[key]; !ok {
+			api.Spec.ResponseTemplates[key] = templates
+		}
+	}
+}
+
 // Get all the host names defined in the ingress rules,
 // in order to compute the condition for rules with no host,
 // checking that none of the hosts we have processed matches the
@@ -121,6 +153,38 @@ func getHosts(ingress *v1.Ingress) map[string]bool {
 	return hosts
 }
 
+// Get the backends of the canary ingresses, indexed by host and path.
+// If several canaries exist for the same host and path, the first one wins.
+func getCanaryBackends(canaries []Canary) map[string]*canaryBackend {
+	backends := make(map[string]*canaryBackend)
+	for _, canary := range canaries {
+		if canary.Weight <= 0 {
+			continue
+		}
+		for _, rule := range canary.Ingress.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				key := canaryKey(rule.Host, path.Path)
+				if _, ok := backends[key]; ok || path.Backend.Service == nil {
+					continue
+				}
+				backends[key] = &canaryBackend{
+					IngressServiceBackend: path.Backend.Service,
+					namespace:             canary.Ingress.Namespace,
+					weight:                canary.Weight,
+				}
+			}
+		}
+	}
+	return backends
+}
+
+func canaryKey(host, path string) string {
+	return host + path
+}
+
 func buildApiCopy(apiDefinition *v1alpha1.ApiDefinition, ingress *v1.Ingress) *v1alpha1.ApiDefinition {
 	spec := *apiDefinition.Spec.DeepCopy()
 	spec.Name = ingress.Name
@@ -285,27 +349,56 @@ func (m *Mapper) buildNotFoundStep() base.FlowStep {
 	}
 }
 
-func buildProxy(ingress *v1.Ingress) *v2.Proxy {
+// Paths that have a canary are routed to a dedicated endpoint group named after the path,
+// splitting the traffic between the stable and the canary backends with a weighted load balancer.
+// Because groups and endpoints are resolved by name, the routing step is the same in both cases.
+func (m *Mapper) buildProxy(ingress *v1.Ingress) *v2.Proxy {
+	eps := make([]*v2.Endpoint, 0)
+	canaryGroups := make([]*v2.EndpointGroup, 0)
+	for ruleIndex, rule := range ingress.Spec.Rules {
+		for pathIndex := range rule.HTTP.Paths {
+			path := newIndexedPath(&rule.HTTP.Paths[pathIndex], ruleIndex, pathIndex)
+			if canary, ok := m.canaries[canaryKey(rule.Host, path.Path)]; ok {
+				canaryGroups = append(canaryGroups, buildCanaryEndpointGroup(ingress, path, canary))
+			} else {
+				eps = append(eps, buildEndpoint(ingress, path))
+			}
+		}
+	}
+
+	groups := make([]*v2.EndpointGroup, 0)
+	if len(eps) > 0 || len(canaryGroups) == 0 {
+		groups = append(groups, &v2.EndpointGroup{Name: proxyName, Endpoints: eps})
+	}
+
 	return &v2.Proxy{
 		VirtualHosts: buildVirtualHosts(ingress),
-		Groups: []*v2.EndpointGroup{
-			{
-				Name:      proxyName,
-				Endpoints: buildEndpoints(ingress),
-			},
-		},
+		Groups:       append(groups, canaryGroups...),
 	}
 }
 
-func buildEndpoints(ingress *v1.Ingress) []*v2.Endpoint {
-	eps := make([]*v2.Endpoint, 0)
-	for ruleIndex, rule := range ingress.Spec.Rules {
-		for pathIndex := range rule.HTTP.Paths {
-			path := &rule.HTTP.Paths[pathIndex]
-			eps = append(eps, buildEndpoint(ingress, newIndexedPath(path, ruleIndex, pathIndex)))
-		}
+func buildCanaryEndpointGroup(ingress *v1.Ingress, path *indexedPath, canary *canaryBackend) *v2.EndpointGroup {
+	group := &v2.EndpointGroup{
+		Name:         path.String(),
+		Endpoints:    make([]*v2.Endpoint, 0),
+		LoadBalancer: v2.LoadBalancer{Type: v2.WeightedRoundRobin},
 	}
-	return eps
+
+	if canary.weight < maxWeight {
+		group.Endpoints = append(group.Endpoints, &v2.Endpoint{
+			Name:   fmt.Sprintf(stableEndpointPattern, path),
+			Target: buildEndpointTarget(ingress, path),
+			Weight: maxWeight - canary.weight,
+		})
+	}
+
+	group.Endpoints = append(group.Endpoints, &v2.Endpoint{
+		Name:   fmt.Sprintf(canaryEndpointPattern, path),
+		Target: fmt.Sprintf(serviceURIPattern, canary.Name, canary.namespace, canary.Port.Number),
+		Weight: canary.weight,
+	})
+
+	return group
 }
 
 // For each rule and path of an ingress, build an endpoint identified by the position of the path in the rule,
@@ -17,7 +17,9 @@ package mapper
 import (
 	"net/http"
 
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
 	xhttp "github.com/gravitee-io/gravitee-kubernetes-operator/internal/http"
+	netV1 "k8s.io/api/networking/v1"
 )
 
 const notFoundStatusText = "No context-path matches the request URI."
@@ -29,6 +31,19 @@ type ResponseTemplate struct {
 
 type Opts struct {
 	Templates map[int]ResponseTemplate
+	// Response templates added to the generated API when
+	// the API template does not define them.
+	ResponseTemplates map[string]map[string]*base.ResponseTemplate
+	// Canary ingresses receiving a share of the traffic
+	// for the hosts and paths of the mapped ingress.
+	Canaries []Canary
+}
+
+// Canary is an ingress receiving the given percentage of the traffic
+// sent to the backends exposed with the same host and path.
+type Canary struct {
+	Ingress *netV1.Ingress
+	Weight  int
 }
 
 func NewOpts() Opts {
@@ -44,6 +59,9 @@ func mergeOpts(opts Opts) Opts {
 		baseOpts.Templates[status] = template
 	}
 
+	baseOpts.ResponseTemplates = opts.ResponseTemplates
+	baseOpts.Canaries = opts.Canaries
+
 	return baseOpts
 }
 
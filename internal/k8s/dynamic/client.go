@@ -22,10 +22,16 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
-var dynamicClient *dynamic.DynamicClient
+var dynamicClient dynamic.Interface
 var once sync.Once
 
-func GetClient() *dynamic.DynamicClient {
+// RegisterClient replaces the client built from the configuration of the manager.
+func RegisterClient(c dynamic.Interface) {
+	once.Do(func() {})
+	dynamicClient = c
+}
+
+func GetClient() dynamic.Interface {
 	once.Do(func() {
 		dynamicClient = dynamic.NewForConfigOrDie(ctrl.GetConfigOrDie())
 	})
@@ -39,6 +39,12 @@ var ManagementContextGVR = schema.GroupVersionResource{
 	Resource: core.CRDManagementContextResource,
 }
 
+var ClusterManagementContextGVR = schema.GroupVersionResource{
+	Group:    core.CRDGroup,
+	Version:  core.CRDVersion,
+	Resource: core.CRDClusterManagementContextResource,
+}
+
 var ResourceGVR = schema.GroupVersionResource{
 	Group:    core.CRDGroup,
 	Version:  core.CRDVersion,
@@ -50,3 +56,9 @@ var SecretGVR = schema.GroupVersionResource{
 	Version:  "v1",
 	Resource: "secrets",
 }
+
+var NamespaceGVR = schema.GroupVersionResource{
+	Group:    "",
+	Version:  "v1",
+	Resource: "namespaces",
+}
@@ -16,9 +16,12 @@ package dynamic
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
 )
 
 func ExpectResolvedContext(ctx context.Context, ref core.ObjectRef, parentNs string) error {
@@ -28,13 +31,79 @@ func ExpectResolvedContext(ctx context.Context, ref core.ObjectRef, parentNs str
 	return nil
 }
 
+// ResolveContext resolves the management context referenced from the parent namespace.
+// References of kind ClusterManagementContext are resolved at the cluster level,
+// and the secrets of the cluster context are read from the namespace of the operator.
 func ResolveContext(ctx context.Context, ref core.ObjectRef, parentNs string) (*management.Context, error) {
-	context, err := resolveRefSpec(ctx, ref, parentNs, ManagementContextGVR, new(management.Context))
+	gvr, secretNs := ManagementContextGVR, parentNs
+	if ref.GetKind() == core.CRDClusterManagementContextKind {
+		gvr, secretNs = ClusterManagementContextGVR, env.Config.OperatorNS
+	}
+
+	context, err := resolveRefSpec(ctx, ref, parentNs, gvr, new(management.Context))
 	if err != nil {
 		return nil, err
 	}
 
-	return injectSecretIfAny(ctx, context, parentNs)
+	if err = checkNamespaceAllowed(ctx, ref, context, parentNs); err != nil {
+		return nil, err
+	}
+
+	return injectSecretIfAny(ctx, context, secretNs)
+}
+
+// NamespaceNotAllowedError is returned when a resource references
+// a management context its namespace is not allowed to reference.
+type NamespaceNotAllowedError struct {
+	Context   string
+	Namespace string
+}
+
+func (e NamespaceNotAllowedError) Error() string {
+	return fmt.Sprintf(
+		"namespace [%s] is not allowed to reference management context [%s]",
+		e.Namespace, e.Context,
+	)
+}
+
+func IsNamespaceNotAllowed(err error) bool {
+	return errors.As(err, &NamespaceNotAllowedError{})
+}
+
+func checkNamespaceAllowed(
+	ctx context.Context,
+	ref core.ObjectRef,
+	mCtx *management.Context,
+	namespace string,
+) error {
+	if namespace == "" || namespace == ref.GetNamespace() {
+		return nil
+	}
+
+	contextName := ref.GetNamespace() + "/" + ref.GetName()
+	if ref.GetKind() == core.CRDClusterManagementContextKind {
+		contextName = ref.GetName()
+	}
+
+	// labels are only read when the namespace is not allowed by name
+	var namespaceLabels map[string]string
+	if allowed, _ := mCtx.AllowsNamespace(namespace, nil); !allowed && mCtx.NamespaceSelector != nil {
+		ns, err := ResolveNamespace(ctx, namespace)
+		if err != nil {
+			return fmt.Errorf("unable to match namespace [%s] against management context selector: %w", namespace, err)
+		}
+		namespaceLabels = ns.Labels
+	}
+
+	allowed, err := mCtx.AllowsNamespace(namespace, namespaceLabels)
+	if err != nil {
+		return fmt.Errorf("invalid namespace selector of management context [%s]: %w", contextName, err)
+	}
+	if !allowed {
+		return NamespaceNotAllowedError{Context: contextName, Namespace: namespace}
+	}
+
+	return nil
 }
 
 func injectSecretIfAny(ctx context.Context, mCtx *management.Context, parentNs string) (*management.Context, error) {
@@ -0,0 +1,28 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dynamic
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+// ResolveNamespace reads a namespace without relying on the cache of the manager,
+// as namespaces are not watched by the operator.
+func ResolveNamespace(ctx context.Context, name string) (*coreV1.Namespace, error) {
+	return resolveRef(ctx, &refs.NamespacedName{Name: name}, "", NamespaceGVR, new(coreV1.Namespace))
+}
@@ -15,21 +15,155 @@
 package k8s
 
 import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
 	netV1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func IsGraviteeIngress(ingress *netV1.Ingress) bool {
-	var ingressClassName string
-	if ingressClassName = ingress.GetAnnotations()[core.IngressClassAnnotation]; ingress.Spec.IngressClassName != nil {
-		ingressClassName = *(ingress.Spec.IngressClassName)
+const maxCanaryWeight = 100
+
+// IsGraviteeIngress returns true if the ingress is handled by the operator, either because its class
+// is listed in the INGRESS_CLASSES setting or because its class references Gravitee ingress class parameters.
+// Ingress classes are read from the cache, this must not be called from index functions.
+func IsGraviteeIngress(ctx context.Context, ingress *netV1.Ingress) bool {
+	if HasConfiguredIngressClass(ingress) {
+		return true
 	}
 
+	return isParameterizedIngressClass(ctx, GetIngressClassName(ingress))
+}
+
+// HasConfiguredIngressClass returns true if the class of the ingress is listed in the INGRESS_CLASSES setting.
+func HasConfiguredIngressClass(ingress *netV1.Ingress) bool {
+	ingressClassName := GetIngressClassName(ingress)
+
 	for _, ingressClass := range env.Config.IngressClasses {
 		if ingressClassName == ingressClass {
 			return true
 		}
 	}
+
 	return false
 }
+
+// GetIngressClassName returns the class of the ingress, read from its spec
+// or from the legacy ingress class annotation.
+func GetIngressClassName(ingress *netV1.Ingress) string {
+	var ingressClassName string
+	if ingressClassName = ingress.GetAnnotations()[core.IngressClassAnnotation]; ingress.Spec.IngressClassName != nil {
+		ingressClassName = *(ingress.Spec.IngressClassName)
+	}
+	return ingressClassName
+}
+
+// IsCanaryIngress returns true if the ingress is annotated as a canary of the ingresses
+// exposing the same hosts and paths, in which case no API is generated for it.
+func IsCanaryIngress(ingress *netV1.Ingress) bool {
+	return ingress.GetAnnotations()[core.IngressCanaryAnnotation] == env.TrueString
+}
+
+// GetCanaryWeight returns the percentage of the traffic routed to the backends of a canary ingress.
+func GetCanaryWeight(ingress *netV1.Ingress) (int, error) {
+	value, ok := ingress.GetAnnotations()[core.IngressCanaryWeightAnnotation]
+	if !ok {
+		return 0, nil
+	}
+
+	weight, err := strconv.Atoi(value)
+	if err != nil || weight < 0 || weight > maxCanaryWeight {
+		return 0, fmt.Errorf(
+			"annotation [%s] must be an integer between 0 and %d, got %s",
+			core.IngressCanaryWeightAnnotation, maxCanaryWeight, value,
+		)
+	}
+
+	return weight, nil
+}
+
+// GetIngressClassParameters returns the Gravitee parameters referenced by the class of the ingress.
+// Nil is returned if the ingress has no class or if its class does not reference Gravitee parameters.
+func GetIngressClassParameters(
+	ctx context.Context,
+	ingress *netV1.Ingress,
+) (*v1alpha1.GraviteeIngressClassParameters, error) {
+	ingressClassName := GetIngressClassName(ingress)
+	if ingressClassName == "" || cli == nil {
+		return nil, nil
+	}
+
+	ingressClass := &netV1.IngressClass{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: ingressClassName}, ingressClass); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+
+	ref := ingressClass.Spec.Parameters
+	if !isGraviteeParametersReference(ref) {
+		return nil, nil
+	}
+
+	if ref.Namespace == nil || *ref.Namespace == "" {
+		return nil, fmt.Errorf(
+			"ingress class [%s] must reference its parameters with a namespace scope", ingressClassName,
+		)
+	}
+
+	params := &v1alpha1.GraviteeIngressClassParameters{}
+	if err := cli.Get(ctx, types.NamespacedName{Namespace: *ref.Namespace, Name: ref.Name}, params); err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
+
+func isParameterizedIngressClass(ctx context.Context, ingressClassName string) bool {
+	if ingressClassName == "" || cli == nil {
+		return false
+	}
+
+	ingressClass := &netV1.IngressClass{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: ingressClassName}, ingressClass); err != nil {
+		return false
+	}
+
+	return isGraviteeParametersReference(ingressClass.Spec.Parameters)
+}
+
+func isGraviteeParametersReference(ref *netV1.IngressClassParametersReference) bool {
+	return ref != nil &&
+		ref.APIGroup != nil &&
+		*ref.APIGroup == core.CRDGroup &&
+		ref.Kind == core.CRDIngressClassParametersKind
+}
+
+// UpdateIngressStatus reports the gateway address defined in the ingress class
+// parameters in the load balancer status of the ingress.
+func UpdateIngressStatus(ctx context.Context, ingress *netV1.Ingress) error {
+	params, err := GetIngressClassParameters(ctx, ingress)
+	if err != nil || params == nil || params.Spec.GatewayAddress == "" {
+		return err
+	}
+
+	desired := []netV1.IngressLoadBalancerIngress{newLoadBalancerIngress(params.Spec.GatewayAddress)}
+	if equality.Semantic.DeepEqual(ingress.Status.LoadBalancer.Ingress, desired) {
+		return nil
+	}
+
+	ingress.Status.LoadBalancer.Ingress = desired
+	return cli.Status().Update(ctx, ingress)
+}
+
+func newLoadBalancerIngress(address string) netV1.IngressLoadBalancerIngress {
+	if net.ParseIP(address) != nil {
+		return netV1.IngressLoadBalancerIngress{IP: address}
+	}
+	return netV1.IngressLoadBalancerIngress{Hostname: address}
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ResolveValue reads the value referenced by the given config map or secret key.
+// Config maps and secrets are read from the given namespace.
+func ResolveValue(ctx context.Context, from *refs.ValueFrom, namespace string) ([]byte, error) {
+	switch {
+	case from == nil:
+		return nil, nil
+	case from.ConfigMapKeyRef != nil:
+		ref := from.ConfigMapKeyRef
+		cm := &coreV1.ConfigMap{}
+		if err := GetClient().Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, cm); err != nil {
+			return nil, err
+		}
+		if value, ok := cm.Data[ref.Key]; ok {
+			return []byte(value), nil
+		}
+		if value, ok := cm.BinaryData[ref.Key]; ok {
+			return value, nil
+		}
+		return nil, fmt.Errorf("key [%s] not found in config map [%s]", ref.Key, cm.Name)
+	case from.SecretKeyRef != nil:
+		ref := from.SecretKeyRef
+		secret := &coreV1.Secret{}
+		if err := GetClient().Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+			return nil, err
+		}
+		if value, ok := secret.Data[ref.Key]; ok {
+			return value, nil
+		}
+		return nil, fmt.Errorf("key [%s] not found in secret [%s]", ref.Key, secret.Name)
+	default:
+		return nil, fmt.Errorf("either a config map or a secret key reference is required")
+	}
+}
+
+const dataURIPrefix = "data:"
+
+// ResolveDataURI reads the value referenced by the given config map or secret key as a data URI,
+// raw bytes being base64 encoded with their detected content type.
+func ResolveDataURI(ctx context.Context, from *refs.ValueFrom, namespace string) (string, error) {
+	if from == nil {
+		return "", nil
+	}
+
+	value, err := ResolveValue(ctx, from, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	if uri := strings.TrimSpace(string(value)); strings.HasPrefix(uri, dataURIPrefix) {
+		return uri, nil
+	}
+
+	return fmt.Sprintf(
+		"%s%s;base64,%s", dataURIPrefix, http.DetectContentType(value), base64.StdEncoding.EncodeToString(value),
+	), nil
+}
+
+// ResolveConfigMapEntries reads all the entries of the referenced config map,
+// binary entries being converted to strings.
+// The config map is read from the given namespace, whatever the namespace of the reference.
+func ResolveConfigMapEntries(
+	ctx context.Context,
+	ref *refs.NamespacedName,
+	namespace string,
+) (map[string]string, error) {
+	cm := &coreV1.ConfigMap{}
+	if err := GetClient().Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, cm); err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]string, len(cm.Data)+len(cm.BinaryData))
+	for key, value := range cm.BinaryData {
+		entries[key] = string(value)
+	}
+	for key, value := range cm.Data {
+		entries[key] = value
+	}
+
+	return entries, nil
+}
+
+// ResolveSecretEntries reads all the entries of the referenced secret.
+// The secret is read from the given namespace, whatever the namespace of the reference.
+func ResolveSecretEntries(
+	ctx context.Context,
+	ref *refs.NamespacedName,
+	namespace string,
+) (map[string]string, error) {
+	secret := &coreV1.Secret{}
+	if err := GetClient().Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]string, len(secret.Data))
+	for key, value := range secret.Data {
+		entries[key] = string(value)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keystore
+
+import (
+	"bytes"
+	"crypto/x509"
+
+	jks "github.com/pavlo-v-chernykh/keystore-go/v4"
+)
+
+const jksCertType = "X509"
+
+func (enc *Encoder) encodeJKS(entries []Entry, password string) ([]byte, error) {
+	store := jks.New(
+		jks.WithOrderedAliases(),
+		jks.WithCustomRandomNumberGenerator(enc.rand),
+	)
+
+	for _, entry := range entries {
+		key, err := x509.MarshalPKCS8PrivateKey(entry.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		chain := make([]jks.Certificate, 0, len(entry.Chain))
+		for _, cert := range entry.Chain {
+			chain = append(chain, jks.Certificate{Type: jksCertType, Content: cert.Raw})
+		}
+
+		privateKeyEntry := jks.PrivateKeyEntry{
+			CreationTime:     enc.now(),
+			PrivateKey:       key,
+			CertificateChain: chain,
+		}
+		if err = store.SetPrivateKeyEntry(entry.Alias, privateKeyEntry, []byte(password)); err != nil {
+			return nil, err
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := store.Store(buf, []byte(password)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
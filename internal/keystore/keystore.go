@@ -0,0 +1,69 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keystore
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	JKS    = "jks"
+	PKCS12 = "pkcs12"
+)
+
+// Entry is a private key entry of a keystore, identified by its alias.
+type Entry struct {
+	Alias string
+	Key   crypto.PrivateKey
+	Chain []*x509.Certificate
+}
+
+// Encoder builds keystores from private key entries.
+type Encoder struct {
+	rand io.Reader
+	now  func() time.Time
+}
+
+func NewEncoder() *Encoder {
+	return &Encoder{
+		rand: rand.Reader,
+		now:  time.Now,
+	}
+}
+
+// Encode builds a keystore of the given type (jks or pkcs12),
+// protecting both the store and its keys with the given password.
+func (enc *Encoder) Encode(storeType string, entries []Entry, password string) ([]byte, error) {
+	for _, entry := range entries {
+		if len(entry.Chain) == 0 {
+			return nil, fmt.Errorf("keystore entry [%s] has no certificate", entry.Alias)
+		}
+	}
+
+	switch strings.ToLower(storeType) {
+	case JKS:
+		return enc.encodeJKS(entries, password)
+	case PKCS12:
+		return enc.encodePKCS12(entries, password)
+	default:
+		return nil, fmt.Errorf("unsupported keystore type %s", storeType)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keystore
+
+import (
+	"bytes"
+	"fmt"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// PKCS12 keystores can only hold a single private key. Entries sharing the
+// same certificate (e.g. one alias per host of an ingress TLS definition)
+// are collapsed into this key.
+func (enc *Encoder) encodePKCS12(entries []Entry, password string) ([]byte, error) {
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("pkcs12 keystores require a private key entry")
+	}
+
+	entry := entries[0]
+	for _, other := range entries[1:] {
+		if !bytes.Equal(other.Chain[0].Raw, entry.Chain[0].Raw) {
+			return nil, fmt.Errorf(
+				"pkcs12 keystores can only hold a single private key, found [%s] and [%s], use a jks keystore instead",
+				entry.Alias, other.Alias,
+			)
+		}
+	}
+
+	return pkcs12.Modern.WithRand(enc.rand).Encode(entry.Key, entry.Chain[0], entry.Chain[1:], password)
+}
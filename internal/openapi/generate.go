@@ -0,0 +1,246 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/utils"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+)
+
+const (
+	DefaultPageKey = "openapi"
+
+	proxyType           = "http-proxy"
+	defaultGroupName    = "Default HTTP proxy group"
+	defaultEndpointName = "Default HTTP proxy"
+	validationPolicy    = "oas-validation"
+	specResourceName    = "OpenAPI Specification"
+	specResourceType    = "content-provider-inline-resource"
+	validationStepName  = "OpenAPI Specification Validation"
+	specResourceKey     = "content"
+	targetConfigKey     = "target"
+	resourceNameKey     = "resourceName"
+)
+
+var pathParamPattern = regexp.MustCompile(`{([^}/]+)}`)
+
+// Resolve generates the missing parts of a v4 API from the OpenAPI specification it references, if any.
+// Listeners and endpoint groups are only generated when the API does not declare any, and generated
+// flows, resources and pages never replace the ones declared with the same name in the API.
+func Resolve(ctx context.Context, api core.ApiDefinitionObject) error {
+	impl, ok := api.GetDefinition().(*v4.Api)
+	if !ok || impl.OpenAPI == nil {
+		return nil
+	}
+
+	content, err := k8s.ResolveValue(ctx, impl.OpenAPI.From, api.GetNamespace())
+	if err != nil {
+		return fmt.Errorf("unable to resolve OpenAPI specification: %w", err)
+	}
+
+	raw, doc, err := Parse(content)
+	if err != nil {
+		return fmt.Errorf("unable to parse OpenAPI specification: %w", err)
+	}
+
+	spec, err := Load(ctx, doc, raw)
+	if err != nil {
+		return fmt.Errorf("invalid OpenAPI specification: %w", err)
+	}
+
+	return Generate(impl, string(content), ServerPaths(doc, spec), spec)
+}
+
+// Generate completes the API with the listeners, endpoints, flows and page derived from
+// the given specification and the paths of its servers.
+func Generate(api *v4.Api, content string, serverPaths []string, spec *openapi3.T) error {
+	if len(api.Listeners) == 0 {
+		listener, err := newListener(serverPaths)
+		if err != nil {
+			return err
+		}
+		api.Listeners = []*v4.GenericListener{listener}
+	}
+
+	if len(api.EndpointGroups) == 0 {
+		if api.OpenAPI.Backend == "" {
+			return fmt.Errorf("a backend is required to generate the endpoints of the API from its OpenAPI specification")
+		}
+		api.EndpointGroups = []*v4.EndpointGroup{newEndpointGroup(api.OpenAPI.Backend)}
+	}
+
+	if api.OpenAPI.ValidateRequest {
+		addSpecResource(api, content)
+	}
+
+	addFlows(api, spec)
+	addPage(api, content, spec)
+
+	return nil
+}
+
+func newListener(serverPaths []string) (*v4.GenericListener, error) {
+	paths := make([]*v4.Path, 0)
+	known := make(map[string]bool)
+	for _, path := range serverPaths {
+		if path == "/" || known[path] {
+			continue
+		}
+		known[path] = true
+		paths = append(paths, &v4.Path{Path: path + "/"})
+	}
+
+	if len(paths) == 0 {
+		return nil, fmt.Errorf(
+			"unable to derive a context path from the servers of the OpenAPI specification, a listener is required",
+		)
+	}
+
+	return v4.ToGenericListener(&v4.HttpListener{
+		AbstractListener: &v4.AbstractListener{
+			Type:        v4.HTTPListenerType,
+			Entrypoints: []*v4.Entrypoint{{Type: proxyType, Qos: v4.AutoQOS}},
+		},
+		Paths:        paths,
+		PathMappings: []string{},
+	}), nil
+}
+
+func newEndpointGroup(backend string) *v4.EndpointGroup {
+	return &v4.EndpointGroup{
+		Name: defaultGroupName,
+		Type: proxyType,
+		Endpoints: []*v4.Endpoint{
+			{
+				Name:   defaultEndpointName,
+				Type:   proxyType,
+				Weight: 1,
+				Config: utils.NewGenericStringMap().Put(targetConfigKey, backend),
+			},
+		},
+	}
+}
+
+func addSpecResource(api *v4.Api, content string) {
+	for _, resource := range api.Resources {
+		if resource != nil && resource.Resource != nil && resource.Name == specResourceName {
+			return
+		}
+	}
+	api.Resources = append(api.Resources, &base.ResourceOrRef{
+		Resource: &base.Resource{
+			Enabled:       true,
+			Name:          specResourceName,
+			Type:          specResourceType,
+			Configuration: utils.NewGenericStringMap().Put(specResourceKey, content),
+		},
+	})
+}
+
+// Each operation of the specification becomes a flow named after its operation ID,
+// or after its method and path when the operation has no ID.
+func addFlows(api *v4.Api, spec *openapi3.T) {
+	declared := make(map[string]bool)
+	for _, flow := range api.Flows {
+		if flow != nil {
+			declared[flow.Name] = true
+		}
+	}
+
+	items := spec.Paths.Map()
+	paths := make([]string, 0, len(items))
+	for path := range items {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		operations := items[path].Operations()
+		methods := make([]string, 0, len(operations))
+		for method := range operations {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+
+		for _, method := range methods {
+			name := operations[method].OperationID
+			if name == "" {
+				name = method + " " + path
+			}
+			if declared[name] {
+				continue
+			}
+			declared[name] = true
+			api.Flows = append(api.Flows, newFlow(api, name, path, method))
+		}
+	}
+}
+
+func newFlow(api *v4.Api, name, path, method string) *v4.Flow {
+	flow := v4.NewFlow(name)
+	flow.Selectors = append(flow.Selectors, v4.NewHTTPSelector(
+		pathParamPattern.ReplaceAllString(path, ":$1"),
+		string(base.EqualsOperator),
+		[]base.HttpMethod{base.HttpMethod(method)},
+	))
+	if api.OpenAPI.ValidateRequest {
+		flow.Request = append(flow.Request, &v4.FlowStep{
+			FlowStep: base.FlowStep{
+				Enabled:       true,
+				Name:          validationStepName,
+				Policy:        validationPolicy,
+				Configuration: utils.NewGenericStringMap().Put(resourceNameKey, specResourceName),
+			},
+		})
+	}
+	return flow
+}
+
+func addPage(api *v4.Api, content string, spec *openapi3.T) {
+	key := api.OpenAPI.PageKey
+	if key == "" {
+		key = DefaultPageKey
+	}
+	if api.Pages == nil {
+		api.Pages = make(map[string]*v4.Page)
+	}
+	if _, ok := api.Pages[key]; ok {
+		return
+	}
+
+	name := key
+	if spec.Info != nil && spec.Info.Title != "" {
+		name = spec.Info.Title
+	}
+
+	api.Pages[key] = &v4.Page{
+		Page: &base.Page{
+			Name:       name,
+			Type:       base.SwaggerPageType,
+			Content:    content,
+			Published:  true,
+			Visibility: "PUBLIC",
+		},
+	}
+}
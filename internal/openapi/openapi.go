@@ -0,0 +1,89 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+	"sigs.k8s.io/yaml"
+)
+
+// Parse converts a JSON or YAML document to JSON and returns it along with its root object.
+func Parse(content []byte) ([]byte, map[string]interface{}, error) {
+	raw, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("neither a valid JSON nor a valid YAML document: %w", err)
+	}
+
+	doc := make(map[string]interface{})
+	if err = json.Unmarshal(raw, &doc); err != nil {
+		return nil, nil, fmt.Errorf("an object is expected at the root of the document: %w", err)
+	}
+
+	return raw, doc, nil
+}
+
+// Load reads an OpenAPI 3 document, or an OpenAPI 2 document converted to its OpenAPI 3 equivalent.
+// External references are not allowed.
+func Load(ctx context.Context, doc map[string]interface{}, raw []byte) (*openapi3.T, error) {
+	switch {
+	case doc["openapi"] != nil:
+		loader := openapi3.NewLoader()
+		loader.Context = ctx
+		loader.IsExternalRefsAllowed = false
+		return loader.LoadFromData(raw)
+	case doc["swagger"] != nil:
+		spec := new(openapi2.T)
+		if err := json.Unmarshal(raw, spec); err != nil {
+			return nil, err
+		}
+		return openapi2conv.ToV3(spec)
+	default:
+		return nil, fmt.Errorf("either an openapi or a swagger version is required")
+	}
+}
+
+// ServerPaths returns the base paths declared by the servers of an OpenAPI 3 document,
+// or by the base path of an OpenAPI 2 document. Server URLs using variables are ignored.
+func ServerPaths(doc map[string]interface{}, spec *openapi3.T) []string {
+	paths := make([]string, 0)
+	if basePath, ok := doc["basePath"].(string); ok {
+		return append(paths, NormalizePath(basePath))
+	}
+	if doc["swagger"] != nil {
+		return paths
+	}
+	for _, server := range spec.Servers {
+		if server == nil || strings.Contains(server.URL, "{") {
+			continue
+		}
+		if u, err := url.Parse(server.URL); err == nil {
+			paths = append(paths, NormalizePath(u.Path))
+		}
+	}
+	return paths
+}
+
+// NormalizePath returns the given path with a single leading slash and no trailing slash.
+func NormalizePath(path string) string {
+	return "/" + strings.Trim(path, "/")
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package overlay applies the API patches of a management context
+// to the definition of the APIs synced with this context.
+package overlay
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	v2 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v2"
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"sigs.k8s.io/yaml"
+)
+
+// Apply patches the definition of the API with the patches of the context, in order.
+func Apply(context core.ContextModel, api core.ApiDefinitionObject) error {
+	mCtx, ok := context.(*management.Context)
+	if !ok || len(mCtx.ApiPatches) == 0 {
+		return nil
+	}
+
+	version := string(api.GetDefinitionVersion())
+	patches := make([]management.ApiPatch, 0, len(mCtx.ApiPatches))
+	for _, patch := range mCtx.ApiPatches {
+		if patch.AppliesTo(version) {
+			patches = append(patches, patch)
+		}
+	}
+
+	switch def := api.GetDefinition().(type) {
+	case *v4.Api:
+		return apply(def, patches)
+	case *v2.Api:
+		return apply(def, patches)
+	default:
+		return fmt.Errorf("unable to patch API definition of type %T", def)
+	}
+}
+
+func apply[T any](def *T, patches []management.ApiPatch) error {
+	if len(patches) == 0 {
+		return nil
+	}
+
+	doc, err := json.Marshal(def)
+	if err != nil {
+		return err
+	}
+
+	for i := range patches {
+		if doc, err = Patch(doc, &patches[i]); err != nil {
+			return fmt.Errorf("unable to apply API patch #%d of management context: %w", i, err)
+		}
+	}
+
+	patched := new(T)
+	if err = json.Unmarshal(doc, patched); err != nil {
+		return fmt.Errorf("patched API definition is invalid: %w", err)
+	}
+
+	*def = *patched
+	return nil
+}
+
+// Patch applies a single patch to a JSON document.
+func Patch(doc []byte, patch *management.ApiPatch) ([]byte, error) {
+	content, err := yaml.YAMLToJSON([]byte(patch.Patch))
+	if err != nil {
+		return nil, err
+	}
+
+	if patch.Type == management.JSONPatchType {
+		ops, err := jsonpatch.DecodePatch(content)
+		if err != nil {
+			return nil, err
+		}
+		return ops.Apply(doc)
+	}
+
+	return jsonpatch.MergePatch(doc, content)
+}
+
+// Validate checks that the patch can be decoded, without applying it.
+func Validate(patch *management.ApiPatch) error {
+	content, err := yaml.YAMLToJSON([]byte(patch.Patch))
+	if err != nil {
+		return err
+	}
+
+	if patch.Type == management.JSONPatchType {
+		_, err = jsonpatch.DecodePatch(content)
+		return err
+	}
+
+	var object map[string]interface{}
+	if err = json.Unmarshal(content, &object); err != nil {
+		return fmt.Errorf("a merge patch must be an object: %w", err)
+	}
+	return nil
+}
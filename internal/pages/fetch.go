@@ -0,0 +1,216 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pages
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/utils"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+)
+
+// ServiceFetcherType is the type of the page sources fetched by the operator
+// from a service running in the cluster, whereas other sources are handed over to APIM.
+const ServiceFetcherType = "service-fetcher"
+
+const (
+	serviceNameKey      = "serviceName"
+	serviceNamespaceKey = "serviceNamespace"
+	portKey             = "port"
+	pathKey             = "path"
+	fetchIntervalKey    = "fetchInterval"
+
+	defaultPort          = 80
+	defaultPath          = "/openapi.json"
+	defaultFetchInterval = 5 * time.Minute
+	fetchTimeout         = 10 * time.Second
+	maxContentSize       = 5 << 20
+)
+
+var httpClient = &http.Client{Timeout: fetchTimeout}
+
+// ServiceSource locates the document of a page served by a service of the cluster.
+type ServiceSource struct {
+	Name          string
+	Namespace     string
+	Port          int
+	Path          string
+	FetchInterval time.Duration
+}
+
+// URL returns the in-cluster URL of the document.
+func (s *ServiceSource) URL() string {
+	return fmt.Sprintf("http://%s.%s.svc:%d%s", s.Name, s.Namespace, s.Port, s.Path)
+}
+
+// NewServiceSource reads the configuration of a service fetcher page source.
+// The service must run in the namespace of the API, so that an API cannot read documents
+// served by the services of other namespaces.
+func NewServiceSource(namespace string, config *utils.GenericStringMap) (*ServiceSource, error) {
+	source := &ServiceSource{
+		Namespace:     namespace,
+		Port:          defaultPort,
+		Path:          defaultPath,
+		FetchInterval: defaultFetchInterval,
+	}
+	if config == nil {
+		return nil, fmt.Errorf("a %s configuration is required", serviceNameKey)
+	}
+
+	if source.Name = config.GetString(serviceNameKey); source.Name == "" {
+		return nil, fmt.Errorf("a %s is required", serviceNameKey)
+	}
+	if ns := config.GetString(serviceNamespaceKey); ns != "" && ns != namespace {
+		return nil, fmt.Errorf(
+			"%s must be the namespace of the API [%s], got [%s]", serviceNamespaceKey, namespace, ns,
+		)
+	}
+	if path := config.GetString(pathKey); path != "" {
+		source.Path = "/" + strings.TrimLeft(path, "/")
+	}
+
+	if port := config.Get(portKey); port != nil {
+		value, err := strconv.Atoi(fmt.Sprint(port))
+		if err != nil || value < 1 || value > 65535 {
+			return nil, fmt.Errorf("%s must be a valid port number, got [%v]", portKey, port)
+		}
+		source.Port = value
+	}
+
+	if interval := config.GetString(fetchIntervalKey); interval != "" {
+		value, err := time.ParseDuration(interval)
+		if err != nil || value < time.Second {
+			return nil, fmt.Errorf("%s must be a duration of at least one second, got [%s]", fetchIntervalKey, interval)
+		}
+		source.FetchInterval = value
+	}
+
+	return source, nil
+}
+
+// Fetch sets the content of the pages of the given API sourced from services of the cluster.
+// Service fetcher sources are removed from the pages, even when the document cannot be fetched,
+// so that they are never handed over to APIM.
+func Fetch(ctx context.Context, api core.ApiDefinitionObject) error {
+	var fetchErr error
+	pages := Get(api)
+	for _, key := range sortedKeys(pages) {
+		page := pages[key]
+		if !IsServiceSourced(page) {
+			continue
+		}
+		source, err := NewServiceSource(api.GetNamespace(), page.Source.Configuration)
+		page.Source = nil
+		if err == nil {
+			page.Content, err = fetch(ctx, source)
+		}
+		if err != nil && fetchErr == nil {
+			fetchErr = fmt.Errorf("unable to fetch content of page [%s]: %w", key, err)
+		}
+	}
+	return fetchErr
+}
+
+// Hash fetches the documents of the pages of the given API sourced from services of the cluster
+// and returns a hash of their content, along with the shortest fetch interval of these pages.
+// An empty hash is returned if no page is sourced from a service.
+func Hash(ctx context.Context, api core.ApiDefinitionObject) (string, time.Duration, error) {
+	pages := Get(api)
+	digest := sha256.New()
+	var interval time.Duration
+	for _, key := range sortedKeys(pages) {
+		page := pages[key]
+		if !IsServiceSourced(page) {
+			continue
+		}
+		source, err := NewServiceSource(api.GetNamespace(), page.Source.Configuration)
+		if err != nil {
+			return "", defaultFetchInterval, fmt.Errorf("page [%s]: %w", key, err)
+		}
+		if interval == 0 || source.FetchInterval < interval {
+			interval = source.FetchInterval
+		}
+		content, err := fetch(ctx, source)
+		if err != nil {
+			return "", interval, fmt.Errorf("unable to fetch content of page [%s]: %w", key, err)
+		}
+		digest.Write([]byte(key))
+		digest.Write([]byte(content))
+	}
+	if interval == 0 {
+		return "", 0, nil
+	}
+	return hex.EncodeToString(digest.Sum(nil)), interval, nil
+}
+
+// RemoveServiceSources removes the service fetcher sources from the pages of the given API without fetching
+// their content, so that the API can be validated without reaching the services of the cluster.
+func RemoveServiceSources(api core.ApiDefinitionObject) {
+	for _, page := range Get(api) {
+		if IsServiceSourced(page) {
+			page.Source = nil
+		}
+	}
+}
+
+// IsServiceSourced returns true if the content of the page is fetched by the operator from a service.
+func IsServiceSourced(page *base.Page) bool {
+	return page != nil && page.Source != nil && page.Source.Type == ServiceFetcherType
+}
+
+// HasServiceSources returns true if any page of the API is fetched by the operator from a service.
+func HasServiceSources(api core.ApiDefinitionObject) bool {
+	for _, page := range Get(api) {
+		if IsServiceSourced(page) {
+			return true
+		}
+	}
+	return false
+}
+
+func fetch(ctx context.Context, source *ServiceSource) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source.URL(), http.NoBody)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GET %s returned status %d", source.URL(), resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxContentSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(body) > maxContentSize {
+		return "", fmt.Errorf("GET %s returned a document larger than %d bytes", source.URL(), maxContentSize)
+	}
+	return string(body), nil
+}
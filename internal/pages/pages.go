@@ -0,0 +1,221 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pages
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	v2 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v2"
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+)
+
+// PathSeparator separates the segments of the path of a page in the keys of a config map,
+// slashes not being allowed in config map keys.
+const PathSeparator = "__"
+
+var asyncAPIPattern = regexp.MustCompile(`(?m)^\s*"?asyncapi"?\s*:`)
+
+// Resolve reads the content of the pages of the given API from config maps and secrets,
+// and expands the folders sourced from config maps into page trees.
+// Resolved contents are set on the pages of the API and expanded pages are added to it.
+func Resolve(ctx context.Context, api core.ApiDefinitionObject) error {
+	switch impl := api.GetDefinition().(type) {
+	case *v2.Api:
+		return resolveV2(ctx, api.GetNamespace(), impl)
+	case *v4.Api:
+		return resolveV4(ctx, api.GetNamespace(), impl)
+	default:
+		return nil
+	}
+}
+
+// Get returns the pages of the given API, keyed by their name in the API spec.
+func Get(api core.ApiDefinitionObject) map[string]*base.Page {
+	pages := make(map[string]*base.Page)
+	switch impl := api.GetDefinition().(type) {
+	case *v2.Api:
+		for key, page := range impl.Pages {
+			if page != nil && page.Page != nil {
+				pages[key] = page.Page
+			}
+		}
+	case *v4.Api:
+		for key, page := range impl.Pages {
+			if page != nil && page.Page != nil {
+				pages[key] = page.Page
+			}
+		}
+	}
+	return pages
+}
+
+func resolveV2(ctx context.Context, namespace string, api *v2.Api) error {
+	for _, key := range sortedKeys(api.Pages) {
+		page := api.Pages[key]
+		if page == nil || page.Page == nil {
+			continue
+		}
+		expanded, err := resolve(ctx, namespace, key, page.Page)
+		if err != nil {
+			return err
+		}
+		for name, p := range expanded {
+			if _, ok := api.Pages[name]; !ok {
+				api.Pages[name] = &v2.Page{
+					Page:                  p,
+					AccessControls:        page.AccessControls,
+					ExcludedAccessControl: page.ExcludedAccessControl,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func resolveV4(ctx context.Context, namespace string, api *v4.Api) error {
+	for _, key := range sortedKeys(api.Pages) {
+		page := api.Pages[key]
+		if page == nil || page.Page == nil {
+			continue
+		}
+		expanded, err := resolve(ctx, namespace, key, page.Page)
+		if err != nil {
+			return err
+		}
+		for name, p := range expanded {
+			if _, ok := api.Pages[name]; !ok {
+				api.Pages[name] = &v4.Page{Page: p}
+			}
+		}
+	}
+	return nil
+}
+
+func resolve(ctx context.Context, namespace, key string, page *base.Page) (map[string]*base.Page, error) {
+	if page.ContentFrom != nil {
+		content, err := k8s.ResolveValue(ctx, page.ContentFrom, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve content of page [%s]: %w", key, err)
+		}
+		page.Content = string(content)
+	}
+
+	if page.PagesFrom == nil {
+		return nil, nil
+	}
+
+	if page.Type != base.FolderPageType {
+		return nil, fmt.Errorf("page [%s] must be a folder to be expanded from a config map", key)
+	}
+
+	if ns := page.PagesFrom.Namespace; ns != "" && ns != namespace {
+		return nil, fmt.Errorf(
+			"config map of page [%s] must be in the namespace of the API [%s], got [%s]", key, namespace, ns,
+		)
+	}
+
+	entries, err := k8s.ResolveConfigMapEntries(ctx, page.PagesFrom, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to expand page [%s] from config map [%s]: %w", key, page.PagesFrom, err)
+	}
+
+	return Expand(key, page, entries), nil
+}
+
+// Expand returns the pages defined by the given config map entries, keyed by their path
+// under the given folder. Intermediate folders are created for each segment of the entries path,
+// and entries whose extension does not match a page type are ignored.
+func Expand(folderKey string, folder *base.Page, entries map[string]string) map[string]*base.Page {
+	pages := make(map[string]*base.Page)
+	for i, entry := range sortedKeys(entries) {
+		segments := make([]string, 0)
+		for _, segment := range strings.Split(entry, PathSeparator) {
+			if segment != "" {
+				segments = append(segments, segment)
+			}
+		}
+		if len(segments) == 0 {
+			continue
+		}
+
+		file := segments[len(segments)-1]
+		pageType := TypeOf(file, entries[entry])
+		if pageType == "" {
+			continue
+		}
+
+		parent := folderKey
+		for _, dir := range segments[:len(segments)-1] {
+			key := parent + "/" + dir
+			if _, ok := pages[key]; !ok {
+				pages[key] = newPage(folder, dir, base.FolderPageType, parent)
+			}
+			parent = key
+		}
+
+		name := strings.TrimSuffix(file, path.Ext(file))
+		page := newPage(folder, name, pageType, parent)
+		page.Content = entries[entry]
+		page.Order = uint64(i)
+		pages[parent+"/"+name] = page
+	}
+	return pages
+}
+
+// TypeOf returns the type of the page matching the extension of the given file name,
+// OpenAPI and AsyncAPI specifications being told apart by their content.
+// An empty string is returned if the extension does not match any type.
+func TypeOf(file, content string) string {
+	switch strings.ToLower(path.Ext(file)) {
+	case ".md", ".markdown":
+		return base.MarkdownPageType
+	case ".adoc", ".asciidoc":
+		return base.AsciidocPageType
+	case ".json", ".yaml", ".yml":
+		if asyncAPIPattern.MatchString(content) {
+			return base.AsyncAPIPageType
+		}
+		return base.SwaggerPageType
+	default:
+		return ""
+	}
+}
+
+func newPage(folder *base.Page, name, pageType, parent string) *base.Page {
+	return &base.Page{
+		Name:       name,
+		Type:       pageType,
+		Published:  folder.Published,
+		Visibility: folder.Visibility,
+		Parent:     parent,
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/hash"
 	corev1 "k8s.io/api/core/v1"
 	netV1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
@@ -44,15 +45,51 @@ func (LastSpecHashPredicate) Create(e event.CreateEvent) bool {
 			t.Status.ProcessingStatus != core.ProcessingStatusCompleted
 	case *v1alpha1.ManagementContext:
 		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec)
+	case *v1alpha1.ClusterManagementContext:
+		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec)
 	case *v1alpha1.ApiResource:
 		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec)
 	case *v1alpha1.Application:
 		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec) ||
 			t.Status.ProcessingStatus != core.ProcessingStatusCompleted
+	case *v1alpha1.Group:
+		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec) ||
+			t.Status.ProcessingStatus != core.ProcessingStatusCompleted
+	case *v1alpha1.Category:
+		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec) ||
+			t.Status.ProcessingStatus != core.ProcessingStatusCompleted
+	case *v1alpha1.Dictionary:
+		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec) ||
+			t.Status.ProcessingStatus != core.ProcessingStatusCompleted
+	case *v1alpha1.SharedPolicyGroup:
+		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec) ||
+			t.Status.ProcessingStatus != core.ProcessingStatusCompleted
+	case *v1alpha1.Tenant:
+		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec) ||
+			t.Status.ProcessingStatus != core.ProcessingStatusCompleted
+	case *v1alpha1.ShardingTag:
+		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec) ||
+			t.Status.ProcessingStatus != core.ProcessingStatusCompleted
+	case *v1alpha1.Role:
+		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec) ||
+			t.Status.ProcessingStatus != core.ProcessingStatusCompleted
+	case *v1alpha1.IdentityProvider:
+		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec) ||
+			t.Status.ProcessingStatus != core.ProcessingStatusCompleted
+	case *v1alpha1.PortalTheme:
+		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec) ||
+			t.Status.ProcessingStatus != core.ProcessingStatusCompleted
+	case *v1alpha1.PortalPage:
+		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec) ||
+			t.Status.ProcessingStatus != core.ProcessingStatusCompleted
 	case *netV1.Ingress:
 		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec)
+	case *v1alpha1.GraviteeIngressClassParameters:
+		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Spec)
 	case *corev1.Secret:
 		return e.Object.GetAnnotations()[core.LastSpecHashAnnotation] != hash.Calculate(&t.Data)
+	case *corev1.ConfigMap:
+		return true
 	default:
 		return false
 	}
@@ -71,26 +108,91 @@ func (LastSpecHashPredicate) Update(e event.UpdateEvent) bool {
 	switch no := e.ObjectNew.(type) {
 	case *v1alpha1.ApiDefinition:
 		oo, _ := e.ObjectOld.(*v1alpha1.ApiDefinition)
-		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec) || fetchedPagesChanged(oo, no)
 	case *v1alpha1.ApiV4Definition:
 		oo, _ := e.ObjectOld.(*v1alpha1.ApiV4Definition)
-		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec) || fetchedPagesChanged(oo, no)
 	case *v1alpha1.ManagementContext:
 		oo, _ := e.ObjectOld.(*v1alpha1.ManagementContext)
 		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
+	case *v1alpha1.ClusterManagementContext:
+		oo, _ := e.ObjectOld.(*v1alpha1.ClusterManagementContext)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
 	case *v1alpha1.ApiResource:
 		oo, _ := e.ObjectOld.(*v1alpha1.ApiResource)
 		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
 	case *v1alpha1.Application:
 		oo, _ := e.ObjectOld.(*v1alpha1.Application)
 		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
+	case *v1alpha1.Group:
+		// resources referencing a group are reconciled once the group becomes available
+		oo, _ := e.ObjectOld.(*v1alpha1.Group)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec) ||
+			no.Status.ProcessingStatus != oo.Status.ProcessingStatus
+	case *v1alpha1.Category:
+		// APIs referencing a category are reconciled once the category becomes available
+		oo, _ := e.ObjectOld.(*v1alpha1.Category)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec) ||
+			no.Status.ProcessingStatus != oo.Status.ProcessingStatus
+	case *v1alpha1.Dictionary:
+		oo, _ := e.ObjectOld.(*v1alpha1.Dictionary)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
+	case *v1alpha1.SharedPolicyGroup:
+		// APIs using a shared policy group are reconciled once the group becomes available
+		oo, _ := e.ObjectOld.(*v1alpha1.SharedPolicyGroup)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec) ||
+			no.Status.ProcessingStatus != oo.Status.ProcessingStatus
+	case *v1alpha1.Tenant:
+		oo, _ := e.ObjectOld.(*v1alpha1.Tenant)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
+	case *v1alpha1.ShardingTag:
+		oo, _ := e.ObjectOld.(*v1alpha1.ShardingTag)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
+	case *v1alpha1.Role:
+		oo, _ := e.ObjectOld.(*v1alpha1.Role)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
+	case *v1alpha1.IdentityProvider:
+		oo, _ := e.ObjectOld.(*v1alpha1.IdentityProvider)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
+	case *v1alpha1.PortalTheme:
+		oo, _ := e.ObjectOld.(*v1alpha1.PortalTheme)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
+	case *v1alpha1.PortalPage:
+		oo, _ := e.ObjectOld.(*v1alpha1.PortalPage)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
 	case *netV1.Ingress:
 		oo, _ := e.ObjectOld.(*netV1.Ingress)
+		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec) || canaryChanged(oo, no)
+	case *v1alpha1.GraviteeIngressClassParameters:
+		oo, _ := e.ObjectOld.(*v1alpha1.GraviteeIngressClassParameters)
 		return hash.Calculate(&no.Spec) != hash.Calculate(&oo.Spec)
 	case *corev1.Secret:
 		oo, _ := e.ObjectOld.(*corev1.Secret)
 		return hash.Calculate(&no.Data) != hash.Calculate(&oo.Data)
+	case *corev1.ConfigMap:
+		// resources reading values from a config map (e.g. dictionaries or API pages) are synced again on change
+		oo, _ := e.ObjectOld.(*corev1.ConfigMap)
+		return hash.Calculate(&no.Data) != hash.Calculate(&oo.Data) ||
+			hash.Calculate(&no.BinaryData) != hash.Calculate(&oo.BinaryData)
 	default:
 		return false
 	}
 }
+
+// Canary annotations change the API generated for the ingresses
+// exposing the same hosts, even though the spec of the canary is unchanged.
+func canaryChanged(oldIngress, newIngress *netV1.Ingress) bool {
+	for _, key := range []string{core.IngressCanaryAnnotation, core.IngressCanaryWeightAnnotation} {
+		if oldIngress.GetAnnotations()[key] != newIngress.GetAnnotations()[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// The hash of the pages fetched from services changes when a fetched document has been updated.
+// The first hash is ignored as the documents have been fetched when the API was first synced.
+func fetchedPagesChanged(oldAPI, newAPI client.Object) bool {
+	oldHash := oldAPI.GetAnnotations()[core.FetchedPagesHashAnnotation]
+	return oldHash != "" && oldHash != newAPI.GetAnnotations()[core.FetchedPagesHashAnnotation]
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"fmt"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	"golang.org/x/net/context"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AssertContextUnused returns an error if any resource is relying on the given management context
+// or cluster management context, which must not be deleted until then.
+func AssertContextUnused(ctx context.Context, instance client.Object) error {
+	ref := refs.NewNamespacedName(instance.GetNamespace(), instance.GetName())
+
+	apis := &v1alpha1.ApiDefinitionList{}
+	if err := FindByFieldReferencing(
+		ctx,
+		indexer.ApiContextField,
+		ref,
+		apis,
+	); err != nil {
+		err = fmt.Errorf("an error occurred while checking if the management context is linked to an api definition: %w", err)
+		return err
+	}
+
+	if len(apis.Items) > 0 {
+		return fmt.Errorf("can not delete %s because %d api(s) relying on this context",
+			instance.GetName(), len(apis.Items))
+	}
+
+	apisV4 := &v1alpha1.ApiV4DefinitionList{}
+	if err := FindByFieldReferencing(
+		ctx,
+		indexer.ApiV4ContextField,
+		ref,
+		apisV4,
+	); err != nil {
+		err = fmt.Errorf("can not check if the management context is linked to an api v4 definition: %w", err)
+		return err
+	}
+
+	if len(apisV4.Items) > 0 {
+		return fmt.Errorf("can not delete %s because %d api(s) relying on this context",
+			instance.GetName(), len(apisV4.Items))
+	}
+
+	apps := &v1alpha1.ApplicationList{}
+	err := FindByFieldReferencing(
+		ctx,
+		indexer.AppContextField,
+		ref,
+		apps,
+	)
+
+	if err != nil {
+		err = fmt.Errorf("an error occurred while checking if the management context is linked to an application: %w", err)
+		return err
+	}
+
+	if len(apps.Items) > 0 {
+		return fmt.Errorf("can not delete %s because %d application(s) are relying on this context",
+			instance.GetName(), len(apps.Items))
+	}
+
+	return checkContextReferences(ctx, instance.GetName(), ref)
+}
+
+// contextReference describes a kind of resource that cannot outlive the management context it references.
+type contextReference struct {
+	field indexer.IndexField
+	list  client.ObjectList
+	kind  string
+}
+
+func checkContextReferences(ctx context.Context, name string, ref refs.NamespacedName) error {
+	references := []contextReference{
+		{indexer.GroupContextField, &v1alpha1.GroupList{}, "group"},
+		{indexer.CategoryContextField, &v1alpha1.CategoryList{}, "category"},
+		{indexer.DictionaryContextField, &v1alpha1.DictionaryList{}, "dictionary"},
+		{indexer.SharedPolicyGroupContextField, &v1alpha1.SharedPolicyGroupList{}, "shared policy group"},
+		{indexer.TenantContextField, &v1alpha1.TenantList{}, "tenant"},
+		{indexer.ShardingTagContextField, &v1alpha1.ShardingTagList{}, "sharding tag"},
+		{indexer.RoleContextField, &v1alpha1.RoleList{}, "role"},
+		{indexer.IdentityProviderContextField, &v1alpha1.IdentityProviderList{}, "identity provider"},
+		{indexer.PortalThemeContextField, &v1alpha1.PortalThemeList{}, "portal theme"},
+		{indexer.PortalPageContextField, &v1alpha1.PortalPageList{}, "portal page"},
+	}
+
+	for _, reference := range references {
+		if err := FindByFieldReferencing(
+			ctx,
+			reference.field,
+			ref,
+			reference.list,
+		); err != nil {
+			return fmt.Errorf(
+				"an error occurred while checking if the management context is linked to a %s: %w", reference.kind, err,
+			)
+		}
+
+		if count := meta.LenList(reference.list); count > 0 {
+			return fmt.Errorf("can not delete %s because %d %s resource(s) are relying on this context",
+				name, count, reference.kind)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sharedpolicygroups resolves the shared policy group resources
+// referenced by the flow steps of v4 APIs.
+package sharedpolicygroups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PendingError is returned when the API references shared policy groups that have not been synced with APIM yet.
+type PendingError struct {
+	Groups []string
+}
+
+func (e PendingError) Error() string {
+	return fmt.Sprintf("shared policy groups [%s] have not been synced yet", strings.Join(e.Groups, ", "))
+}
+
+// GetPending returns the groups listed by the given error if it is a PendingError.
+func GetPending(err error) ([]string, bool) {
+	pending := PendingError{}
+	if errors.As(err, &pending) {
+		return pending.Groups, true
+	}
+	return nil, false
+}
+
+// Resolve sets the policy of the flow steps referencing a shared policy group resource,
+// using the ID the group has been given in the APIM instance of the management context of the API.
+// Groups must be synced with that context, as their ID is only known from the APIM instance of the context.
+// A PendingError listing the groups that have not been synced yet is returned once all steps have been visited.
+func Resolve(ctx context.Context, api *v1alpha1.ApiV4Definition) error {
+	pending := make([]string, 0)
+	for _, flow := range api.Spec.GetAllFlows() {
+		if flow == nil {
+			continue
+		}
+		for _, steps := range flow.StepsByPhase() {
+			for _, step := range steps {
+				if step == nil || !step.HasSharedPolicyGroupRef() {
+					continue
+				}
+
+				group, err := Get(ctx, api.Namespace, step.SharedPolicyGroup)
+				if err != nil {
+					return err
+				}
+
+				if !IsManagedWith(group, api) {
+					return fmt.Errorf(
+						"shared policy group [%s/%s] is not synced with management context [%s] of the API",
+						group.Namespace, group.Name, ContextKey(api.Spec.Context, api.Namespace),
+					)
+				}
+
+				if !isSynced(group) {
+					pending = append(pending, group.GetNamespace()+"/"+group.GetName())
+					continue
+				}
+
+				step.UseSharedPolicyGroup(group.Status.ID)
+			}
+		}
+	}
+
+	if len(pending) > 0 {
+		slices.Sort(pending)
+		return PendingError{Groups: slices.Compact(pending)}
+	}
+
+	return nil
+}
+
+// Get returns the shared policy group referenced by a flow step,
+// a reference without a namespace being resolved in the given namespace.
+func Get(ctx context.Context, namespace string, ref *refs.NamespacedName) (*v1alpha1.SharedPolicyGroup, error) {
+	if ref.HasNameSpace() {
+		namespace = ref.Namespace
+	}
+
+	group := new(v1alpha1.SharedPolicyGroup)
+	if err := k8s.GetClient().Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// IsManagedWith returns true if the group is synced with the management context the API targets.
+func IsManagedWith(group *v1alpha1.SharedPolicyGroup, api *v1alpha1.ApiV4Definition) bool {
+	if api.Spec.Context == nil {
+		return false
+	}
+	return ContextKey(group.Spec.Context, group.Namespace) == ContextKey(api.Spec.Context, api.Namespace)
+}
+
+// isSynced returns true if the group has been given an ID by APIM and its last sync succeeded.
+func isSynced(group *v1alpha1.SharedPolicyGroup) bool {
+	return group.Status.ID != "" && group.Status.ProcessingStatus == core.ProcessingStatusCompleted
+}
+
+// ContextKey identifies a management context as namespace/name,
+// a reference without namespace being resolved in the given namespace.
+func ContextKey(ref *refs.NamespacedName, namespace string) string {
+	if ref == nil {
+		return ""
+	}
+	key := *ref
+	if key.IsMissingNamespace() {
+		key.SetNamespace(namespace)
+	}
+	return key.String()
+}
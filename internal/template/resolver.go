@@ -24,6 +24,7 @@ import (
 
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
 	netv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -42,7 +43,9 @@ const ksPropertyLength = 2
 func Compile(ctx context.Context, obj runtime.Object) error {
 	switch t := obj.(type) {
 	case *v1alpha1.ApiDefinition, *v1alpha1.ApiV4Definition, *v1alpha1.ManagementContext,
-		*v1alpha1.Application, *netv1.Ingress, *v1alpha1.ApiResource:
+		*v1alpha1.Application, *netv1.Ingress, *v1alpha1.ApiResource, *v1alpha1.Group, *v1alpha1.Category,
+		*v1alpha1.Dictionary, *v1alpha1.SharedPolicyGroup, *v1alpha1.Tenant, *v1alpha1.ShardingTag,
+		*v1alpha1.Role, *v1alpha1.IdentityProvider, *v1alpha1.PortalTheme, *v1alpha1.PortalPage:
 		return exec(ctx, obj)
 	default:
 		return fmt.Errorf("unsupported object type %v", t)
@@ -158,6 +161,9 @@ func addFinalizer(ctx context.Context, obj client.Object) error {
 		}
 
 		util.AddFinalizer(object, core.TemplatingFinalizer)
+		if secret, ok := object.(*v1.Secret); ok && secret.Type == v1.SecretTypeTLS {
+			k8s.AddLabel(secret, core.CertificateValidationLabel, env.TrueString)
+		}
 
 		return cli.Update(ctx, object)
 	}
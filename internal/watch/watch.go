@@ -21,12 +21,17 @@ import (
 
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/search"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/types/list"
+	coreV1 "k8s.io/api/core/v1"
+	netV1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -36,9 +41,18 @@ import (
 
 type Interface interface {
 	WatchContexts(index indexer.IndexField) *handler.Funcs
+	WatchClusterContexts(b *builder.Builder, index indexer.IndexField) *builder.Builder
 	WatchResources(index indexer.IndexField) *handler.Funcs
 	WatchApiTemplate() *handler.Funcs
 	WatchTLSSecret() *handler.Funcs
+	WatchIngressClassParameters() *handler.Funcs
+	WatchCanaryIngresses() *handler.Funcs
+	WatchGroups(index indexer.IndexField) *handler.Funcs
+	WatchCategories(index indexer.IndexField) *handler.Funcs
+	WatchConfigMaps(index indexer.IndexField) *handler.Funcs
+	WatchSecrets(index indexer.IndexField) *handler.Funcs
+	WatchSharedPolicyGroups(index indexer.IndexField) *handler.Funcs
+	WatchPortalPages(index indexer.IndexField) *handler.Funcs
 }
 
 type UpdateFunc = func(context.Context, event.UpdateEvent, workqueue.RateLimitingInterface)
@@ -70,6 +84,48 @@ func (w *Type) WatchContexts(index indexer.IndexField) *handler.Funcs {
 	}
 }
 
+// WatchClusterContexts adds a watch on cluster management contexts to the given builder
+// when the operator is cluster scoped, cluster scoped resources being out of reach otherwise.
+// The secrets of cluster contexts are watched as well, so that rotated credentials are used right away.
+func (w *Type) WatchClusterContexts(b *builder.Builder, index indexer.IndexField) *builder.Builder {
+	if !env.IsClusterScoped() {
+		return b
+	}
+	return b.
+		Watches(&v1alpha1.ClusterManagementContext{}, w.WatchContexts(index)).
+		Watches(&coreV1.Secret{}, w.watchClusterContextSecrets(index))
+}
+
+// Only updates are watched, secrets being all listed as created when the operator starts.
+func (w *Type) watchClusterContextSecrets(index indexer.IndexField) *handler.Funcs {
+	return &handler.Funcs{
+		UpdateFunc: func(_ context.Context, e event.UpdateEvent, q workqueue.RateLimitingInterface) {
+			w.queueByClusterContextSecret(e.ObjectNew, index, q)
+		},
+	}
+}
+
+func (w *Type) queueByClusterContextSecret(
+	secret client.Object,
+	index indexer.IndexField,
+	q workqueue.RateLimitingInterface,
+) {
+	if secret.GetNamespace() != env.Config.OperatorNS {
+		return
+	}
+
+	ref := refs.NewNamespacedName(secret.GetNamespace(), secret.GetName())
+	contexts := &v1alpha1.ClusterManagementContextList{}
+	if err := search.FindByFieldReferencing(w.ctx, indexer.ClusterContextSecretField, ref, contexts); err != nil {
+		log.FromContext(w.ctx).Error(err, "error while searching for cluster contexts", "secret", ref.String())
+		return
+	}
+
+	for i := range contexts.Items {
+		w.queueByFieldReferencing(index, refs.NewNamespacedName("", contexts.Items[i].Name), q)
+	}
+}
+
 func ContextSecrets() *handler.Funcs {
 	queueSecrets := func(obj client.Object, q workqueue.RateLimitingInterface) {
 		ctx, ok := obj.(*v1alpha1.ManagementContext)
@@ -110,6 +166,83 @@ func (w *Type) WatchResources(index indexer.IndexField) *handler.Funcs {
 	}
 }
 
+// WatchGroups can be used to trigger a reconciliation when a group is created or updated
+// on resources that are referencing the group by its name, e.g. APIs and applications.
+func (w *Type) WatchGroups(index indexer.IndexField) *handler.Funcs {
+	queueByGroupName := func(obj client.Object, q workqueue.RateLimitingInterface) {
+		if group, ok := obj.(*v1alpha1.Group); ok {
+			w.queueByFieldReferencing(index, refs.NewNamespacedName(group.Namespace, group.Spec.Name), q)
+		}
+	}
+
+	return &handler.Funcs{
+		CreateFunc: func(_ context.Context, e event.CreateEvent, q workqueue.RateLimitingInterface) {
+			queueByGroupName(e.Object, q)
+		},
+		UpdateFunc: func(_ context.Context, e event.UpdateEvent, q workqueue.RateLimitingInterface) {
+			queueByGroupName(e.ObjectNew, q)
+		},
+	}
+}
+
+// WatchCategories can be used to trigger a reconciliation when a category is created or updated
+// on APIs referencing the category by its name or key.
+func (w *Type) WatchCategories(index indexer.IndexField) *handler.Funcs {
+	queueByCategory := func(obj client.Object, q workqueue.RateLimitingInterface) {
+		if category, ok := obj.(*v1alpha1.Category); ok {
+			w.queueByFieldReferencing(index, refs.NewNamespacedName(category.Namespace, category.Spec.Name), q)
+			if category.Status.Key != "" {
+				w.queueByFieldReferencing(index, refs.NewNamespacedName(category.Namespace, category.Status.Key), q)
+			}
+		}
+	}
+
+	return &handler.Funcs{
+		CreateFunc: func(_ context.Context, e event.CreateEvent, q workqueue.RateLimitingInterface) {
+			queueByCategory(e.Object, q)
+		},
+		UpdateFunc: func(_ context.Context, e event.UpdateEvent, q workqueue.RateLimitingInterface) {
+			queueByCategory(e.ObjectNew, q)
+		},
+	}
+}
+
+// WatchConfigMaps can be used to trigger a reconciliation when a config map is updated
+// on resources that are reading values from it, e.g. APIs, dictionaries, identity providers and portal themes.
+func (w *Type) WatchConfigMaps(index indexer.IndexField) *handler.Funcs {
+	return &handler.Funcs{
+		CreateFunc: w.CreateFromLookup(index),
+		UpdateFunc: w.UpdateFromLookup(index),
+	}
+}
+
+// WatchSecrets can be used to trigger a reconciliation when a secret is updated
+// on resources that are reading values from it, e.g. APIs, dictionaries, identity providers and portal themes.
+func (w *Type) WatchSecrets(index indexer.IndexField) *handler.Funcs {
+	return &handler.Funcs{
+		CreateFunc: w.CreateFromLookup(index),
+		UpdateFunc: w.UpdateFromLookup(index),
+	}
+}
+
+// WatchSharedPolicyGroups can be used to trigger a reconciliation when a shared policy group
+// is created or updated on APIs using the group in their flows.
+func (w *Type) WatchSharedPolicyGroups(index indexer.IndexField) *handler.Funcs {
+	return &handler.Funcs{
+		CreateFunc: w.CreateFromLookup(index),
+		UpdateFunc: w.UpdateFromLookup(index),
+	}
+}
+
+// WatchPortalPages can be used to trigger a reconciliation when a portal page is created or updated
+// on the portal pages it contains.
+func (w *Type) WatchPortalPages(index indexer.IndexField) *handler.Funcs {
+	return &handler.Funcs{
+		CreateFunc: w.CreateFromLookup(index),
+		UpdateFunc: w.UpdateFromLookup(index),
+	}
+}
+
 // WatchApiTemplate can be used to trigger a reconciliation when an API template is updated
 // on resources that are depending on it. Right now this is only used for Ingress resources.
 func (w *Type) WatchApiTemplate() *handler.Funcs {
@@ -128,6 +261,60 @@ func (w *Type) WatchTLSSecret() *handler.Funcs {
 	}
 }
 
+// WatchIngressClassParameters can be used to trigger a reconciliation when ingress class parameters
+// are updated on ingresses of the classes referencing them. This is only used for Ingress resources.
+func (w *Type) WatchIngressClassParameters() *handler.Funcs {
+	return &handler.Funcs{
+		CreateFunc: func(_ context.Context, e event.CreateEvent, q workqueue.RateLimitingInterface) {
+			w.queueByIngressClassParameters(e.Object, q)
+		},
+		UpdateFunc: func(_ context.Context, e event.UpdateEvent, q workqueue.RateLimitingInterface) {
+			w.queueByIngressClassParameters(e.ObjectNew, q)
+		},
+	}
+}
+
+func (w *Type) queueByIngressClassParameters(params client.Object, q workqueue.RateLimitingInterface) {
+	ref := refs.NewNamespacedName(params.GetNamespace(), params.GetName())
+	ingressClasses := &netV1.IngressClassList{}
+	if err := search.FindByFieldReferencing(w.ctx, indexer.IngressClassParametersField, ref, ingressClasses); err != nil {
+		log.FromContext(w.ctx).Error(err, "error while searching for ingress classes referencing", "reference", ref.String())
+		return
+	}
+
+	for i := range ingressClasses.Items {
+		w.queueByFieldReferencing(indexer.IngressClassField, refs.NewNamespacedName("", ingressClasses.Items[i].Name), q)
+	}
+}
+
+// WatchCanaryIngresses can be used to trigger a reconciliation of the ingresses exposing
+// the hosts of a canary ingress when the canary ingress is created, updated or deleted.
+func (w *Type) WatchCanaryIngresses() *handler.Funcs {
+	return &handler.Funcs{
+		CreateFunc: func(_ context.Context, e event.CreateEvent, q workqueue.RateLimitingInterface) {
+			w.queueByCanaryIngress(e.Object, q)
+		},
+		UpdateFunc: func(_ context.Context, e event.UpdateEvent, q workqueue.RateLimitingInterface) {
+			w.queueByCanaryIngress(e.ObjectOld, q)
+			w.queueByCanaryIngress(e.ObjectNew, q)
+		},
+		DeleteFunc: func(_ context.Context, e event.DeleteEvent, q workqueue.RateLimitingInterface) {
+			w.queueByCanaryIngress(e.Object, q)
+		},
+	}
+}
+
+func (w *Type) queueByCanaryIngress(obj client.Object, q workqueue.RateLimitingInterface) {
+	ingress, ok := obj.(*netV1.Ingress)
+	if !ok || !k8s.IsCanaryIngress(ingress) {
+		return
+	}
+
+	for _, rule := range ingress.Spec.Rules {
+		w.queueByFieldReferencing(indexer.IngressHostField, refs.NewNamespacedName(ingress.Namespace, rule.Host), q)
+	}
+}
+
 // UpdateFromLookup creates an updater function that will trigger an update
 // on all resources that are referencing the updated object.
 // The lookupField is the field that is used to lookup the resources.
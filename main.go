@@ -29,8 +29,20 @@ import (
 	v2Admission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/api/v2"
 	v4Admission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/api/v4"
 	appAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/application"
+	categoryAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/category"
+	dictionaryAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/dictionary"
+	groupAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/group"
+	identityProviderAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/identityprovider"
+	ingressAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ingress"
 	mctxAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/mctx"
+	portalPageAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/portalpage"
+	portalThemeAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/portaltheme"
 	resourceAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/resource"
+	roleAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/role"
+	secretAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/secret"
+	shardingTagAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/shardingtag"
+	sharedPolicyGroupAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/sharedpolicygroup"
+	tenantAdmission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/tenant"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
 	wk "github.com/gravitee-io/gravitee-kubernetes-operator/internal/webhook"
 	"gopkg.in/yaml.v3"
@@ -42,7 +54,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/application"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/category"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/dictionary"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/group"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/identityprovider"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/portalpage"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/portaltheme"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/role"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/secrets"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/shardingtag"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/sharedpolicygroup"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/controllers/apim/tenant"
 	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
 	v1 "k8s.io/api/networking/v1"
 
@@ -121,6 +143,11 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if err := env.Validate(); err != nil {
+		setupLog.Error(err, "invalid operator configuration")
+		os.Exit(1)
+	}
+
 	if !env.Config.EnableMetrics {
 		metricsAddr = "0" // disables metrics
 	}
@@ -223,6 +250,24 @@ func registerControllers(mgr manager.Manager) {
 		os.Exit(1)
 	}
 
+	if err := (&apidefinition.PageFetcher{
+		Client: k8s.GetClient(),
+		Name:   "apidefinition-page-fetcher",
+		Object: &v1alpha1.ApiDefinition{},
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, msg, controller, "ApiDefinition page fetcher")
+		os.Exit(1)
+	}
+
+	if err := (&apidefinition.PageFetcher{
+		Client: k8s.GetClient(),
+		Name:   "apiv4definition-page-fetcher",
+		Object: &v1alpha1.ApiV4Definition{},
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, msg, controller, "ApiV4Definition page fetcher")
+		os.Exit(1)
+	}
+
 	if err := (&managementcontext.Reconciler{
 		Client:   k8s.GetClient(),
 		Scheme:   mgr.GetScheme(),
@@ -232,6 +277,17 @@ func registerControllers(mgr manager.Manager) {
 		setupLog.Error(err, msg, controller, "ManagementContext")
 		os.Exit(1)
 	}
+	// cluster scoped resources can only be watched when the operator is cluster scoped
+	if env.IsClusterScoped() {
+		if err := (&managementcontext.ClusterReconciler{
+			Client:   k8s.GetClient(),
+			Scheme:   mgr.GetScheme(),
+			Recorder: mgr.GetEventRecorderFor("clustermanagementcontext-controller"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, msg, controller, "ClusterManagementContext")
+			os.Exit(1)
+		}
+	}
 	if err := (&ingress.Reconciler{
 		Client:   k8s.GetClient(),
 		Scheme:   mgr.GetScheme(),
@@ -258,10 +314,101 @@ func registerControllers(mgr manager.Manager) {
 		setupLog.Error(err, msg, controller, "Application")
 		os.Exit(1)
 	}
+	if err := (&group.Reconciler{
+		Client:   k8s.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("group-controller"),
+		Watcher:  watch.New(context.Background(), k8s.GetClient(), &v1alpha1.GroupList{}),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, msg, controller, "Group")
+		os.Exit(1)
+	}
+	if err := (&category.Reconciler{
+		Client:   k8s.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("category-controller"),
+		Watcher:  watch.New(context.Background(), k8s.GetClient(), &v1alpha1.CategoryList{}),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, msg, controller, "Category")
+		os.Exit(1)
+	}
+	if err := (&dictionary.Reconciler{
+		Client:   k8s.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("dictionary-controller"),
+		Watcher:  watch.New(context.Background(), k8s.GetClient(), &v1alpha1.DictionaryList{}),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, msg, controller, "Dictionary")
+		os.Exit(1)
+	}
+	if err := (&sharedpolicygroup.Reconciler{
+		Client:   k8s.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("sharedpolicygroup-controller"),
+		Watcher:  watch.New(context.Background(), k8s.GetClient(), &v1alpha1.SharedPolicyGroupList{}),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, msg, controller, "SharedPolicyGroup")
+		os.Exit(1)
+	}
+	if err := (&tenant.Reconciler{
+		Client:   k8s.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("tenant-controller"),
+		Watcher:  watch.New(context.Background(), k8s.GetClient(), &v1alpha1.TenantList{}),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, msg, controller, "Tenant")
+		os.Exit(1)
+	}
+	if err := (&shardingtag.Reconciler{
+		Client:   k8s.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("shardingtag-controller"),
+		Watcher:  watch.New(context.Background(), k8s.GetClient(), &v1alpha1.ShardingTagList{}),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, msg, controller, "ShardingTag")
+		os.Exit(1)
+	}
+	if err := (&role.Reconciler{
+		Client:   k8s.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("role-controller"),
+		Watcher:  watch.New(context.Background(), k8s.GetClient(), &v1alpha1.RoleList{}),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, msg, controller, "Role")
+		os.Exit(1)
+	}
+	if err := (&identityprovider.Reconciler{
+		Client:   k8s.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("identityprovider-controller"),
+		Watcher:  watch.New(context.Background(), k8s.GetClient(), &v1alpha1.IdentityProviderList{}),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, msg, controller, "IdentityProvider")
+		os.Exit(1)
+	}
+	if err := (&portaltheme.Reconciler{
+		Client:   k8s.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("portaltheme-controller"),
+		Watcher:  watch.New(context.Background(), k8s.GetClient(), &v1alpha1.PortalThemeList{}),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, msg, controller, "PortalTheme")
+		os.Exit(1)
+	}
+	if err := (&portalpage.Reconciler{
+		Client:   k8s.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("portalpage-controller"),
+		Watcher:  watch.New(context.Background(), k8s.GetClient(), &v1alpha1.PortalPageList{}),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, msg, controller, "PortalPage")
+		os.Exit(1)
+	}
 
 	if err := (&secrets.Reconciler{
-		Client: k8s.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:   k8s.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("secret-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, msg, controller, "Secret")
 		os.Exit(1)
@@ -364,6 +511,45 @@ func setupAdmissionWebhooks(mgr manager.Manager) error {
 	if err := (mctxAdmission.AdmissionCtrl{}).SetupWithManager(mgr); err != nil {
 		return err
 	}
+	if err := (mctxAdmission.ClusterAdmissionCtrl{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (groupAdmission.AdmissionCtrl{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (categoryAdmission.AdmissionCtrl{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (dictionaryAdmission.AdmissionCtrl{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (sharedPolicyGroupAdmission.AdmissionCtrl{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (tenantAdmission.AdmissionCtrl{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (shardingTagAdmission.AdmissionCtrl{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (roleAdmission.AdmissionCtrl{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (identityProviderAdmission.AdmissionCtrl{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (portalThemeAdmission.AdmissionCtrl{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (portalPageAdmission.AdmissionCtrl{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (secretAdmission.AdmissionCtrl{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+	if err := (ingressAdmission.AdmissionCtrl{}).SetupWithManager(mgr); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -30,7 +30,6 @@ type APIM struct {
 
 	Subscriptions *service.Subscriptions
 	Pages         *service.Pages
-	Org           *service.Org
 	Export        *Export
 }
 
@@ -45,14 +44,12 @@ func NewClient(ctx context.Context) *APIM {
 
 	subscriptions := service.NewSubscriptions(apim.APIs.Client)
 	pages := service.NewPages(apim.APIs.Client)
-	org := service.NewOrg(apim.APIs.Client)
 	export := NewExport(apim.APIs.Client)
 
 	return &APIM{
 		APIM:          apim,
 		Subscriptions: subscriptions,
 		Pages:         pages,
-		Org:           org,
 		Export:        export,
 	}
 }
@@ -0,0 +1,187 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/category"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	admission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/service"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/errors"
+	xhttp "github.com/gravitee-io/gravitee-kubernetes-operator/internal/http"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func registerFakeClient(objects ...ctrlclient.Object) {
+	scheme := runtime.NewScheme()
+	Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	k8s.RegisterClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build())
+}
+
+func messages(errs []*errors.AdmissionError) []string {
+	result := make([]string, 0, len(errs))
+	for _, err := range errs {
+		result = append(result, err.Message)
+	}
+	return result
+}
+
+var _ = Describe("ValidateCategories", func() {
+	ctx := context.Background()
+
+	var server *httptest.Server
+	var instance *apim.APIM
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			defer GinkgoRecover()
+			Expect(json.NewEncoder(w).Encode([]model.Category{
+				{ID: "category-1", Key: "payments", Name: "Payments"},
+			})).To(Succeed())
+		}))
+
+		urls, err := client.NewURLs(server.URL, "DEFAULT", "DEFAULT")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance = &apim.APIM{Categories: service.NewCategories(&client.Client{
+			HTTP: xhttp.NewNoAuthClient(ctx),
+			URLs: urls,
+		})}
+
+		registerFakeClient(&v1alpha1.Category{
+			ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "default"},
+			Spec:       v1alpha1.CategorySpec{Type: category.Type{Name: "Orders"}},
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+		k8s.RegisterClient(nil)
+	})
+
+	It("accepts categories existing in APIM", func() {
+		errs, existing := admission.ValidateCategories(ctx, instance, "default", []string{"payments", "category-1"})
+		Expect(errs.Severe).To(BeEmpty())
+		Expect(errs.Warning).To(BeEmpty())
+		Expect(existing).To(Equal([]string{"payments", "category-1"}))
+	})
+
+	It("warns about categories defined by a resource that has not been synced yet", func() {
+		errs, existing := admission.ValidateCategories(ctx, instance, "default", []string{"payments", "Orders"})
+		Expect(errs.Severe).To(BeEmpty())
+		Expect(messages(errs.Warning)).To(ConsistOf("category [Orders] has not been synced with APIM yet"))
+		Expect(existing).To(Equal([]string{"payments"}))
+	})
+
+	It("rejects categories defined nowhere", func() {
+		errs, existing := admission.ValidateCategories(ctx, instance, "default", []string{"unknown"})
+		Expect(messages(errs.Severe)).To(ConsistOf(ContainSubstring("category [unknown] does not exist in APIM")))
+		Expect(existing).To(BeEmpty())
+	})
+
+	It("rejects categories defined by a resource of another namespace", func() {
+		errs, _ := admission.ValidateCategories(ctx, instance, "other", []string{"Orders"})
+		Expect(messages(errs.Severe)).To(ConsistOf(ContainSubstring("category [Orders] does not exist in APIM")))
+	})
+})
+
+var _ = Describe("ResolveDataURI", func() {
+	ctx := context.Background()
+
+	png := []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n', 0, 0, 0, 0}
+
+	BeforeEach(func() {
+		registerFakeClient(
+			&coreV1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "pictures", Namespace: "default"},
+				Data:       map[string]string{"uri": " data:image/svg+xml;base64,PHN2Zy8+\n"},
+				BinaryData: map[string][]byte{"png": png},
+			},
+			&coreV1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "pictures", Namespace: "images"},
+				Data:       map[string][]byte{"png": png},
+			},
+			&coreV1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "pictures", Namespace: "default"},
+				Data:       map[string][]byte{"jpeg": {0xff, 0xd8, 0xff}},
+			},
+		)
+	})
+
+	AfterEach(func() {
+		k8s.RegisterClient(nil)
+	})
+
+	It("returns an empty value without reference", func() {
+		uri, err := k8s.ResolveDataURI(ctx, nil, "default")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(uri).To(BeEmpty())
+	})
+
+	It("keeps a value that already is a data URI", func() {
+		uri, err := k8s.ResolveDataURI(ctx, &refs.ValueFrom{
+			ConfigMapKeyRef: &refs.KeyRef{Name: "pictures", Key: "uri"},
+		}, "default")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(uri).To(Equal("data:image/svg+xml;base64,PHN2Zy8+"))
+	})
+
+	It("encodes raw bytes with their content type", func() {
+		uri, err := k8s.ResolveDataURI(ctx, &refs.ValueFrom{
+			ConfigMapKeyRef: &refs.KeyRef{Name: "pictures", Key: "png"},
+		}, "default")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(uri).To(HavePrefix("data:image/png;base64,"))
+	})
+
+	It("resolves a secret key in the given namespace only", func() {
+		uri, err := k8s.ResolveDataURI(ctx, &refs.ValueFrom{
+			SecretKeyRef: &refs.KeyRef{Name: "pictures", Key: "jpeg"},
+		}, "default")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(uri).To(HavePrefix("data:image/jpeg;base64,"))
+
+		_, err = k8s.ResolveDataURI(ctx, &refs.ValueFrom{
+			SecretKeyRef: &refs.KeyRef{Name: "pictures", Key: "png"},
+		}, "default")
+		Expect(err).To(MatchError("key [png] not found in secret [pictures]"))
+	})
+
+	It("fails on a missing key", func() {
+		_, err := k8s.ResolveDataURI(ctx, &refs.ValueFrom{
+			ConfigMapKeyRef: &refs.KeyRef{Name: "pictures", Key: "unknown"},
+		}, "default")
+		Expect(err).To(MatchError("key [unknown] not found in config map [pictures]"))
+	})
+})
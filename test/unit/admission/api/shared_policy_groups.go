@@ -0,0 +1,203 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/sharedpolicygroup"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	admission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/sharedpolicygroups"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newSharedPolicyGroup(name string, apiType v4.ApiType, phase v4.FlowPhase) *v1alpha1.SharedPolicyGroup {
+	return &v1alpha1.SharedPolicyGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: v1alpha1.SharedPolicyGroupSpec{
+			Type:    sharedpolicygroup.Type{Name: name, ApiType: apiType, Phase: phase},
+			Context: &refs.NamespacedName{Name: "dev-ctx"},
+		},
+	}
+}
+
+func newSharedPolicyGroupAPI(context *refs.NamespacedName, request, response []string) *v1alpha1.ApiV4Definition {
+	steps := func(groups []string) []*v4.FlowStep {
+		result := make([]*v4.FlowStep, 0, len(groups))
+		for _, group := range groups {
+			result = append(result, &v4.FlowStep{SharedPolicyGroup: &refs.NamespacedName{Name: group}})
+		}
+		return result
+	}
+
+	return &v1alpha1.ApiV4Definition{
+		ObjectMeta: metav1.ObjectMeta{Name: "echo", Namespace: "default"},
+		Spec: v1alpha1.ApiV4DefinitionSpec{
+			Api: v4.Api{
+				ApiBase: &base.ApiBase{Name: "echo"},
+				Type:    v4.ProxyType,
+				Flows: []*v4.Flow{{
+					Request:  append(steps(request), &v4.FlowStep{FlowStep: base.FlowStep{Policy: "rate-limit"}}),
+					Response: steps(response),
+				}},
+			},
+			Context: context,
+		},
+	}
+}
+
+var _ = Describe("ValidateSharedPolicyGroups", func() {
+	ctx := context.Background()
+	devCtx := &refs.NamespacedName{Name: "dev-ctx"}
+
+	BeforeEach(func() {
+		prodGroup := newSharedPolicyGroup("prod-request", v4.ProxyType, v4.RequestPhase)
+		prodGroup.Spec.Context = &refs.NamespacedName{Name: "prod-ctx"}
+
+		registerFakeClient(
+			newSharedPolicyGroup("request", v4.ProxyType, v4.RequestPhase),
+			newSharedPolicyGroup("response", v4.ProxyType, v4.ResponsePhase),
+			newSharedPolicyGroup("message", v4.MessageType, v4.RequestPhase),
+			prodGroup,
+		)
+	})
+
+	AfterEach(func() {
+		k8s.RegisterClient(nil)
+	})
+
+	It("accepts groups matching the phase, the type and the context of the API", func() {
+		errs := admission.ValidateSharedPolicyGroups(ctx,
+			newSharedPolicyGroupAPI(devCtx, []string{"request"}, []string{"response"}))
+		Expect(errs.Severe).To(BeEmpty())
+	})
+
+	DescribeTable("rejects invalid references",
+		func(context *refs.NamespacedName, request, response []string, expected string) {
+			errs := admission.ValidateSharedPolicyGroups(ctx, newSharedPolicyGroupAPI(context, request, response))
+			Expect(messages(errs.Severe)).To(ConsistOf(expected))
+		},
+		Entry("without management context", nil, []string{"request"}, nil,
+			"shared policy group [request] can only be used by an API synced with a management context"),
+		Entry("missing group", devCtx, []string{"unknown"}, nil,
+			"shared policy group [unknown] does not exist"),
+		Entry("other API type", devCtx, []string{"message"}, nil,
+			"shared policy group [message] is defined for MESSAGE APIs and cannot be used by a PROXY API"),
+		Entry("other phase", devCtx, nil, []string{"request"},
+			"shared policy group [request] is defined for the REQUEST phase and cannot be used in the RESPONSE phase"),
+		Entry("other context", devCtx, []string{"prod-request"}, nil,
+			"shared policy group [prod-request] is not synced with management context [default/dev-ctx] of the API"),
+	)
+
+	It("accepts groups synced with each context of an API synced with several contexts", func() {
+		api := newSharedPolicyGroupAPI(nil, []string{"request"}, nil)
+		api.Spec.Contexts = []v4.ContextTarget{{NamespacedName: *devCtx}}
+
+		errs := admission.ValidateSharedPolicyGroups(ctx, api)
+		Expect(errs.Severe).To(BeEmpty())
+	})
+
+	It("rejects groups not synced with one of the contexts of the API", func() {
+		api := newSharedPolicyGroupAPI(nil, []string{"request"}, nil)
+		api.Spec.Contexts = []v4.ContextTarget{
+			{NamespacedName: *devCtx},
+			{NamespacedName: refs.NamespacedName{Name: "prod-ctx"}},
+		}
+
+		errs := admission.ValidateSharedPolicyGroups(ctx, api)
+		Expect(messages(errs.Severe)).To(ConsistOf(
+			"shared policy group [request] is not synced with management context [default/prod-ctx] of the API",
+		))
+	})
+})
+
+var _ = Describe("Resolve shared policy groups", func() {
+	ctx := context.Background()
+	devCtx := &refs.NamespacedName{Name: "dev-ctx"}
+
+	BeforeEach(func() {
+		synced := newSharedPolicyGroup("request", v4.ProxyType, v4.RequestPhase)
+		synced.Status.ID = "group-id"
+		synced.Status.ProcessingStatus = core.ProcessingStatusCompleted
+
+		failed := newSharedPolicyGroup("response", v4.ProxyType, v4.ResponsePhase)
+		failed.Status.ID = "group-id"
+		failed.Status.ProcessingStatus = core.ProcessingStatusFailed
+
+		registerFakeClient(synced, failed, newSharedPolicyGroup("pending", v4.ProxyType, v4.ResponsePhase))
+	})
+
+	AfterEach(func() {
+		k8s.RegisterClient(nil)
+	})
+
+	It("sets the policy of the steps referencing a synced group", func() {
+		api := newSharedPolicyGroupAPI(devCtx, []string{"request"}, nil)
+		Expect(sharedpolicygroups.Resolve(ctx, api)).To(Succeed())
+
+		step := api.Spec.Flows[0].Request[0]
+		Expect(step.Policy).To(Equal(v4.SharedPolicyGroupPolicy))
+		Expect(step.Configuration.GetString(v4.SharedPolicyGroupIDConfig)).To(Equal("group-id"))
+		Expect(api.Spec.Flows[0].Request[1].Policy).To(Equal("rate-limit"))
+	})
+
+	It("reports all the groups that have not been synced", func() {
+		api := newSharedPolicyGroupAPI(devCtx, []string{"request"}, []string{"response", "pending", "response"})
+		err := sharedpolicygroups.Resolve(ctx, api)
+
+		pending, ok := sharedpolicygroups.GetPending(err)
+		Expect(ok).To(BeTrue())
+		Expect(pending).To(Equal([]string{"default/pending", "default/response"}))
+		Expect(api.Spec.Flows[0].Request[0].Policy).To(Equal(v4.SharedPolicyGroupPolicy))
+	})
+
+	It("resolves the groups for the context the API targets", func() {
+		api := newSharedPolicyGroupAPI(nil, []string{"request"}, nil)
+		api.Spec.Contexts = []v4.ContextTarget{{NamespacedName: *devCtx}}
+
+		target := api.ForContext(&api.Spec.Contexts[0])
+		Expect(sharedpolicygroups.Resolve(ctx, target)).To(Succeed())
+		Expect(target.Spec.Flows[0].Request[0].Policy).To(Equal(v4.SharedPolicyGroupPolicy))
+		Expect(api.Spec.Flows[0].Request[0].Policy).To(BeEmpty())
+	})
+
+	It("fails on a group synced with another context", func() {
+		api := newSharedPolicyGroupAPI(&refs.NamespacedName{Name: "prod-ctx"}, []string{"request"}, nil)
+		err := sharedpolicygroups.Resolve(ctx, api)
+		Expect(err).To(MatchError(
+			"shared policy group [default/request] is not synced with management context [default/prod-ctx] of the API",
+		))
+
+		_, ok := sharedpolicygroups.GetPending(err)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("fails on a missing group", func() {
+		api := newSharedPolicyGroupAPI(devCtx, []string{"unknown"}, nil)
+		err := sharedpolicygroups.Resolve(ctx, api)
+		Expect(err).To(HaveOccurred())
+
+		_, ok := sharedpolicygroups.GetPending(err)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,123 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	admission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/api/base"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPageContent(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Page content admission tests suite")
+}
+
+const openAPI3 = `
+openapi: 3.0.3
+info:
+  title: Echo
+  version: "1.0"
+servers:
+  - url: https://api.example.com/echo
+paths:
+  /:
+    get:
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Echo"
+components:
+  schemas:
+    Echo:
+      type: object
+`
+
+const swagger2 = `{
+  "swagger": "2.0",
+  "info": {"title": "Echo", "version": "1.0"},
+  "basePath": "/legacy",
+  "paths": {"/": {"get": {"responses": {"200": {"description": "OK"}}}}}
+}`
+
+const asyncAPI2 = `
+asyncapi: 2.6.0
+info:
+  title: Events
+  version: "1.0"
+channels:
+  orders:
+    subscribe:
+      message:
+        $ref: "#/components/messages/Order"
+`
+
+var _ = Describe("ValidatePageContent", func() {
+	ctx := context.Background()
+
+	It("accepts a valid OpenAPI 3 document served on a context path of the API", func() {
+		errs := admission.ValidatePageContent(ctx, "spec", base.SwaggerPageType, openAPI3, []string{"/echo"})
+		Expect(errs.Severe).To(BeEmpty())
+		Expect(errs.Warning).To(BeEmpty())
+	})
+
+	It("warns when servers do not match any context path", func() {
+		errs := admission.ValidatePageContent(ctx, "spec", base.SwaggerPageType, openAPI3, []string{"/orders"})
+		Expect(errs.Severe).To(BeEmpty())
+		Expect(errs.Warning).To(HaveLen(1))
+	})
+
+	It("validates OpenAPI 2 documents and their base path", func() {
+		errs := admission.ValidatePageContent(ctx, "spec", base.SwaggerPageType, swagger2, []string{"/legacy/"})
+		Expect(errs.Severe).To(BeEmpty())
+		Expect(errs.Warning).To(BeEmpty())
+	})
+
+	It("rejects documents that cannot be parsed", func() {
+		errs := admission.ValidatePageContent(ctx, "spec", base.SwaggerPageType, "openapi: [3.0", nil)
+		Expect(errs.Severe).To(HaveLen(1))
+	})
+
+	It("rejects OpenAPI documents with schema errors", func() {
+		errs := admission.ValidatePageContent(ctx, "spec", base.SwaggerPageType, "openapi: 3.0.3\npaths: {}", nil)
+		Expect(errs.Severe).To(HaveLen(1))
+	})
+
+	It("warns about unresolved references", func() {
+		errs := admission.ValidatePageContent(ctx, "events", base.AsyncAPIPageType, asyncAPI2, nil)
+		Expect(errs.Severe).To(BeEmpty())
+		Expect(errs.Warning).To(HaveLen(1))
+		Expect(errs.Warning[0].Error()).To(ContainSubstring("#/components/messages/Order"))
+	})
+
+	It("rejects AsyncAPI documents without channels", func() {
+		errs := admission.ValidatePageContent(
+			ctx, "events", base.AsyncAPIPageType, "asyncapi: 2.6.0\ninfo:\n  title: Events\n  version: '1'", nil,
+		)
+		Expect(errs.Severe).To(HaveLen(1))
+	})
+
+	It("ignores markdown pages", func() {
+		errs := admission.ValidatePageContent(ctx, "readme", base.MarkdownPageType, "openapi: [", nil)
+		Expect(errs.Severe).To(BeEmpty())
+	})
+})
@@ -0,0 +1,151 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mctx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/mctx"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s/dynamic"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("context allowed namespaces", func() {
+	teamSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}}
+
+	DescribeTable("possible inputs", func(
+		given *management.Context,
+		namespace string,
+		labels map[string]string,
+		expected bool,
+	) {
+		allowed, err := given.AllowsNamespace(namespace, labels)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(Equal(expected))
+	},
+		Entry("unrestricted", &management.Context{}, "team-a", nil, true),
+		Entry("allowed by name",
+			&management.Context{AllowedNamespaces: []string{"team-a"}}, "team-a", nil, true),
+		Entry("not allowed by name",
+			&management.Context{AllowedNamespaces: []string{"team-a"}}, "team-b", nil, false),
+		Entry("matching selector",
+			&management.Context{NamespaceSelector: teamSelector}, "team-b", map[string]string{"team": "payments"}, true),
+		Entry("not matching selector",
+			&management.Context{NamespaceSelector: teamSelector}, "team-b", map[string]string{"team": "orders"}, false),
+		Entry("allowed by name but not matching selector",
+			&management.Context{AllowedNamespaces: []string{"team-a"}, NamespaceSelector: teamSelector}, "team-a", nil, true),
+	)
+
+	It("fails with an invalid selector", func() {
+		given := &management.Context{NamespaceSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Unknown"}},
+		}}
+		_, err := given.AllowsNamespace("team-a", nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("context namespace restrictions", func() {
+	ctx := context.Background()
+	ref := &refs.NamespacedName{Name: "dev-ctx", Namespace: "apim"}
+	teamSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}}
+
+	newRestrictedContext := func(allowed []string, selector *metav1.LabelSelector) *v1alpha1.ManagementContext {
+		return &v1alpha1.ManagementContext{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev-ctx", Namespace: "apim"},
+			Spec: v1alpha1.ManagementContextSpec{Context: &management.Context{
+				BaseUrl:           "http://apim.example.com",
+				AllowedNamespaces: allowed,
+				NamespaceSelector: selector,
+			}},
+		}
+	}
+
+	newLabeledNamespace := func(name, team string) *coreV1.Namespace {
+		return &coreV1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"team": team}}}
+	}
+
+	AfterEach(func() {
+		k8s.RegisterClient(nil)
+	})
+
+	It("always allows the namespace of the context", func() {
+		registerFakeClients(newRestrictedContext([]string{"team-a"}, teamSelector))
+
+		_, err := dynamic.ResolveContext(ctx, ref, "apim")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("does not look the namespace up when it is allowed by name", func() {
+		registerFakeClients(newRestrictedContext([]string{"team-a"}, teamSelector))
+
+		_, err := dynamic.ResolveContext(ctx, ref, "team-a")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("matches the labels of a namespace that is not allowed by name against the selector", func() {
+		registerFakeClients(
+			newRestrictedContext([]string{"team-a"}, teamSelector),
+			newLabeledNamespace("team-b", "payments"),
+			newLabeledNamespace("team-c", "orders"),
+		)
+
+		_, err := dynamic.ResolveContext(ctx, ref, "team-b")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = dynamic.ResolveContext(ctx, ref, "team-c")
+		Expect(dynamic.IsNamespaceNotAllowed(err)).To(BeTrue())
+		Expect(err).To(MatchError("namespace [team-c] is not allowed to reference management context [apim/dev-ctx]"))
+	})
+
+	It("fails when the labels of the namespace cannot be read", func() {
+		registerFakeClients(newRestrictedContext(nil, teamSelector))
+
+		_, err := dynamic.ResolveContext(ctx, ref, "team-b")
+		Expect(err).To(MatchError(ContainSubstring(
+			"unable to match namespace [team-b] against management context selector",
+		)))
+		Expect(dynamic.IsNamespaceNotAllowed(err)).To(BeFalse())
+	})
+
+	It("rejects an invalid selector on update", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("{}"))
+		}))
+		defer server.Close()
+
+		newContext := func(selector *metav1.LabelSelector) *v1alpha1.ManagementContext {
+			mCtx := newRestrictedContext(nil, selector)
+			mCtx.Spec.BaseUrl = server.URL
+			mCtx.Spec.OrgID, mCtx.Spec.EnvID = "DEFAULT", "DEFAULT"
+			mCtx.Spec.Auth = &management.Auth{BearerToken: "token"}
+			return mCtx
+		}
+
+		_, err := mctx.AdmissionCtrl{}.ValidateUpdate(ctx, newContext(nil), newContext(&metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Unknown"}},
+		}))
+		Expect(err).To(MatchError(ContainSubstring("[namespaceSelector] is invalid")))
+	})
+})
@@ -0,0 +1,179 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mctx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ctxref"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/mctx"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionV1 "k8s.io/api/admission/v1"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func newNamespace(name string, annotations map[string]string) *coreV1.Namespace {
+	return &coreV1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations}}
+}
+
+func newDefaultContext(name, namespace string) *v1alpha1.ManagementContext {
+	return &v1alpha1.ManagementContext{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       v1alpha1.ManagementContextSpec{Context: &management.Context{}, Default: true},
+	}
+}
+
+func newApplication(namespace string) *v1alpha1.Application {
+	return &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "portal", Namespace: namespace}}
+}
+
+func withOperation(ctx context.Context, operation admissionV1.Operation) context.Context {
+	return admission.NewContextWithRequest(ctx, admission.Request{
+		AdmissionRequest: admissionV1.AdmissionRequest{Operation: operation},
+	})
+}
+
+var _ = Describe("default context of the namespace", func() {
+	ctx := context.Background()
+
+	AfterEach(func() {
+		k8s.RegisterClient(nil)
+	})
+
+	DescribeTable("resolves the default context",
+		func(annotation string, expected string) {
+			annotations := map[string]string{}
+			if annotation != "" {
+				annotations[core.DefaultContextAnnotation] = annotation
+			}
+			registerFakeClients(newNamespace("team-a", annotations), newDefaultContext("dev-ctx", "team-a"))
+
+			app := newApplication("team-a")
+			Expect(ctxref.SetDefault(ctx, app)).To(Succeed())
+			Expect(app.Spec.Context.String()).To(Equal(expected))
+		},
+		Entry("from the context marked as default", "", "team-a/dev-ctx"),
+		Entry("from a name annotation taking precedence over the context marked as default",
+			"prod-ctx", "team-a/prod-ctx"),
+		Entry("from a namespace/name annotation", "shared/prod-ctx", "shared/prod-ctx"),
+	)
+
+	It("falls back on the context marked as default when the namespace cannot be read", func() {
+		registerFakeClients(newDefaultContext("dev-ctx", "team-a"))
+
+		app := newApplication("team-a")
+		Expect(ctxref.SetDefault(ctx, app)).To(Succeed())
+		Expect(app.Spec.Context.String()).To(Equal("team-a/dev-ctx"))
+	})
+
+	It("ignores the contexts marked as default in other namespaces", func() {
+		registerFakeClients(newNamespace("team-a", nil), newDefaultContext("dev-ctx", "team-b"))
+
+		app := newApplication("team-a")
+		Expect(ctxref.SetDefault(ctx, app)).To(Succeed())
+		Expect(app.Spec.Context).To(BeNil())
+	})
+
+	It("keeps the context referenced by the resource", func() {
+		registerFakeClients(newNamespace("team-a", nil), newDefaultContext("dev-ctx", "team-a"))
+
+		app := newApplication("team-a")
+		app.Spec.Context = &refs.NamespacedName{Name: "prod-ctx", Namespace: "team-a"}
+		Expect(ctxref.SetDefault(ctx, app)).To(Succeed())
+		Expect(app.Spec.Context.String()).To(Equal("team-a/prod-ctx"))
+	})
+
+	It("does not default an API synced with several contexts", func() {
+		registerFakeClients(newNamespace("team-a", nil), newDefaultContext("dev-ctx", "team-a"))
+
+		api := &v1alpha1.ApiV4Definition{ObjectMeta: metav1.ObjectMeta{Name: "echo", Namespace: "team-a"}}
+		api.Spec.Contexts = []v4.ContextTarget{{NamespacedName: refs.NamespacedName{Name: "prod-ctx"}}}
+		Expect(ctxref.SetDefault(ctx, api)).To(Succeed())
+		Expect(api.Spec.Context).To(BeNil())
+	})
+
+	It("defaults resources on creation only", func() {
+		registerFakeClients(newNamespace("team-a", nil), newDefaultContext("dev-ctx", "team-a"))
+
+		created := newApplication("team-a")
+		Expect(ctxref.SetDefault(withOperation(ctx, admissionV1.Create), created)).To(Succeed())
+		Expect(created.Spec.Context.String()).To(Equal("team-a/dev-ctx"))
+
+		updated := newApplication("team-a")
+		Expect(ctxref.SetDefault(withOperation(ctx, admissionV1.Update), updated)).To(Succeed())
+		Expect(updated.Spec.Context).To(BeNil())
+	})
+})
+
+var _ = Describe("single default context of the namespace", func() {
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("{}"))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		k8s.RegisterClient(nil)
+	})
+
+	newContext := func(name string, isDefault bool) *v1alpha1.ManagementContext {
+		return &v1alpha1.ManagementContext{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team-a"},
+			Spec: v1alpha1.ManagementContextSpec{
+				Context: &management.Context{
+					BaseUrl: server.URL,
+					OrgID:   "DEFAULT",
+					EnvID:   "DEFAULT",
+					Auth:    &management.Auth{BearerToken: "token"},
+				},
+				Default: isDefault,
+			},
+		}
+	}
+
+	It("rejects a context marked as default on update when another one is the default", func() {
+		registerFakeClients(newContext("dev-ctx", true), newContext("prod-ctx", false))
+
+		_, err := mctx.AdmissionCtrl{}.ValidateUpdate(
+			context.Background(), newContext("prod-ctx", false), newContext("prod-ctx", true),
+		)
+		Expect(err).To(MatchError(ContainSubstring(
+			"management context [dev-ctx] is already the default context of namespace [team-a]",
+		)))
+	})
+
+	It("accepts the update of the default context", func() {
+		registerFakeClients(newContext("dev-ctx", true), newContext("prod-ctx", false))
+
+		_, err := mctx.AdmissionCtrl{}.ValidateUpdate(
+			context.Background(), newContext("dev-ctx", true), newContext("dev-ctx", true),
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
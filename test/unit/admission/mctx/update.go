@@ -0,0 +1,85 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mctx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/mctx"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("context update", func() {
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("{}"))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newContext := func(patches ...management.ApiPatch) *v1alpha1.ManagementContext {
+		return &v1alpha1.ManagementContext{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev-ctx", Namespace: "default"},
+			Spec: v1alpha1.ManagementContextSpec{
+				Context: &management.Context{
+					BaseUrl:    server.URL,
+					OrgID:      "DEFAULT",
+					EnvID:      "DEFAULT",
+					Auth:       &management.Auth{BearerToken: "token"},
+					ApiPatches: patches,
+				},
+			},
+		}
+	}
+
+	It("accepts a valid update", func() {
+		_, err := mctx.AdmissionCtrl{}.ValidateUpdate(
+			context.Background(),
+			newContext(),
+			newContext(management.ApiPatch{Patch: `{"description": "synced from the cluster"}`}),
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("rejects an API patch that cannot be decoded", func() {
+		_, err := mctx.AdmissionCtrl{}.ValidateUpdate(
+			context.Background(),
+			newContext(),
+			newContext(management.ApiPatch{Patch: "- not an object"}),
+		)
+		Expect(err).To(MatchError(ContainSubstring(
+			"API patch #0 cannot be decoded: a merge patch must be an object",
+		)))
+	})
+
+	It("rejects the removal of the required fields", func() {
+		updated := newContext()
+		updated.Spec.OrgID = ""
+
+		_, err := mctx.AdmissionCtrl{}.ValidateUpdate(context.Background(), newContext(), updated)
+		Expect(err).To(MatchError(ContainSubstring("[orgId] is mandatory when cloud is not enabled")))
+	})
+})
@@ -0,0 +1,242 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package portalpage
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/portalpage"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	admission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/portalpage"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s/dynamic"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const namespace = "default"
+
+func registerFakeClients(objects ...ctrlclient.Object) {
+	scheme := runtime.NewScheme()
+	Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	contexts := []runtime.Object{newContext("dev-ctx"), newContext("prod-ctx")}
+	k8s.RegisterClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build())
+	dynamic.RegisterClient(dynamicfake.NewSimpleDynamicClient(scheme, contexts...))
+}
+
+func newContext(name string) *v1alpha1.ManagementContext {
+	return &v1alpha1.ManagementContext{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: v1alpha1.ManagementContextSpec{Context: &management.Context{
+			BaseUrl: "http://apim.example.com", OrgID: "DEFAULT", EnvID: "DEFAULT",
+		}},
+	}
+}
+
+func newPage(name, kind, parent string) *v1alpha1.PortalPage {
+	page := &v1alpha1.PortalPage{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: v1alpha1.PortalPageSpec{
+			Type:    portalpage.Type{Name: name, Kind: kind},
+			Context: &refs.NamespacedName{Name: "dev-ctx"},
+		},
+	}
+	if kind != base.FolderPageType {
+		page.Spec.Content = "# " + name
+	}
+	if parent != "" {
+		page.Spec.ParentRef = &refs.NamespacedName{Name: parent}
+	}
+	return page
+}
+
+var _ = Describe("Validate portal page", func() {
+	ctx := context.Background()
+	ctrl := admission.AdmissionCtrl{}
+
+	AfterEach(func() {
+		k8s.RegisterClient(nil)
+	})
+
+	It("accepts a page nested in folders managed with the same context", func() {
+		registerFakeClients(
+			newPage("guides", base.FolderPageType, ""),
+			newPage("tutorials", base.FolderPageType, "guides"),
+		)
+
+		warnings, err := ctrl.ValidateCreate(ctx, newPage("first-steps", base.MarkdownPageType, "tutorials"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("rejects a page referencing an unknown management context", func() {
+		registerFakeClients()
+
+		page := newPage("first-steps", base.MarkdownPageType, "")
+		page.Spec.Context.Name = "unknown-ctx"
+
+		_, err := ctrl.ValidateCreate(ctx, page)
+		Expect(err).To(MatchError(ContainSubstring("references management context [default/unknown-ctx]")))
+	})
+
+	It("rejects a folder with a content", func() {
+		registerFakeClients()
+
+		folder := newPage("guides", base.FolderPageType, "")
+		folder.Spec.Content = "# Guides"
+
+		_, err := ctrl.ValidateCreate(ctx, folder)
+		Expect(err).To(MatchError(ContainSubstring(
+			"folder [guides] cannot define a content, a content reference or a source",
+		)))
+	})
+
+	It("rejects a link that does not point anywhere", func() {
+		registerFakeClients()
+
+		link := newPage("status", portalpage.LinkPageType, "")
+		link.Spec.Content = ""
+
+		_, err := ctrl.ValidateCreate(ctx, link)
+		Expect(err).To(MatchError(ContainSubstring("link [status] must define the resource it points to")))
+	})
+
+	It("rejects a page defined twice in the same folder", func() {
+		other := newPage("first-steps", base.MarkdownPageType, "guides")
+		other.Name = "other-first-steps"
+		registerFakeClients(newPage("guides", base.FolderPageType, ""), other)
+
+		_, err := ctrl.ValidateCreate(ctx, newPage("first-steps", base.MarkdownPageType, "guides"))
+		Expect(err).To(MatchError(ContainSubstring(
+			"portal page [first-steps] is already defined by resource [default/other-first-steps] in the same folder",
+		)))
+	})
+
+	It("accepts a page with the same name in another folder", func() {
+		other := newPage("first-steps", base.MarkdownPageType, "")
+		other.Name = "other-first-steps"
+		registerFakeClients(newPage("guides", base.FolderPageType, ""), other)
+
+		_, err := ctrl.ValidateCreate(ctx, newPage("first-steps", base.MarkdownPageType, "guides"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("rejects a second homepage for the same context", func() {
+		home := newPage("home", base.MarkdownPageType, "")
+		home.Spec.HomePage = true
+		registerFakeClients(home)
+
+		page := newPage("welcome", base.MarkdownPageType, "")
+		page.Spec.HomePage = true
+
+		_, err := ctrl.ValidateCreate(ctx, page)
+		Expect(err).To(MatchError(ContainSubstring("the portal homepage of management context")))
+	})
+
+	It("rejects a change of type", func() {
+		registerFakeClients()
+
+		_, err := ctrl.ValidateUpdate(
+			ctx,
+			newPage("first-steps", base.MarkdownPageType, ""),
+			newPage("first-steps", base.AsciidocPageType, ""),
+		)
+		Expect(err).To(MatchError(ContainSubstring(
+			"the type of portal page [first-steps] cannot be changed from [MARKDOWN] to [ASCIIDOC]",
+		)))
+	})
+})
+
+var _ = Describe("Resolve parent of portal page", func() {
+	ctx := context.Background()
+	ctrl := admission.AdmissionCtrl{}
+
+	AfterEach(func() {
+		k8s.RegisterClient(nil)
+	})
+
+	It("defaults the namespace of the parent to the one of the page", func() {
+		page := newPage("first-steps", base.MarkdownPageType, "guides")
+		Expect(page.GetParentRef().String()).To(Equal("default/guides"))
+
+		page.Spec.ParentRef.Namespace = "docs"
+		Expect(page.GetParentRef().String()).To(Equal("docs/guides"))
+
+		Expect(newPage("guides", base.FolderPageType, "").GetParentRef()).To(BeNil())
+	})
+
+	It("rejects a parent that does not exist", func() {
+		registerFakeClients()
+
+		_, err := ctrl.ValidateCreate(ctx, newPage("first-steps", base.MarkdownPageType, "guides"))
+		Expect(err).To(MatchError(ContainSubstring(
+			"unable to resolve parent folder [default/guides] of portal page [first-steps]",
+		)))
+	})
+
+	It("rejects a parent that is not a folder", func() {
+		registerFakeClients(newPage("guide", base.MarkdownPageType, ""))
+
+		_, err := ctrl.ValidateCreate(ctx, newPage("first-steps", base.MarkdownPageType, "guide"))
+		Expect(err).To(MatchError(ContainSubstring("parent [default/guide] of portal page [first-steps] is not a folder")))
+	})
+
+	It("rejects a parent managed with another context", func() {
+		folder := newPage("guides", base.FolderPageType, "")
+		folder.Spec.Context.Name = "prod-ctx"
+		registerFakeClients(folder)
+
+		_, err := ctrl.ValidateCreate(ctx, newPage("first-steps", base.MarkdownPageType, "guides"))
+		Expect(err).To(MatchError(ContainSubstring(
+			"parent folder [default/guides] of portal page [first-steps] is not managed by the same management context",
+		)))
+	})
+
+	It("rejects an ancestor managed with another context", func() {
+		root := newPage("docs", base.FolderPageType, "")
+		root.Spec.Context.Name = "prod-ctx"
+		registerFakeClients(root, newPage("guides", base.FolderPageType, "docs"))
+
+		_, err := ctrl.ValidateCreate(ctx, newPage("first-steps", base.MarkdownPageType, "guides"))
+		Expect(err).To(MatchError(ContainSubstring("parent folder [default/docs]")))
+	})
+
+	It("rejects a folder moved under one of its descendants", func() {
+		registerFakeClients(
+			newPage("guides", base.FolderPageType, "tutorials"),
+			newPage("tutorials", base.FolderPageType, "guides"),
+		)
+
+		_, err := ctrl.ValidateUpdate(
+			ctx,
+			newPage("guides", base.FolderPageType, ""),
+			newPage("guides", base.FolderPageType, "tutorials"),
+		)
+		Expect(err).To(MatchError(ContainSubstring(
+			"parent folder [default/guides] of portal page [guides] introduces a cycle",
+		)))
+	})
+})
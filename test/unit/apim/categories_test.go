@@ -0,0 +1,104 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apim_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/service"
+	xhttp "github.com/gravitee-io/gravitee-kubernetes-operator/internal/http"
+)
+
+var _ = Describe("Categories", func() {
+	var server *httptest.Server
+	var categories *service.Categories
+	var methods []string
+
+	existing := []model.Category{
+		{ID: "category-1", Key: "payments", Name: "Payments"},
+		{ID: "category-2", Key: "orders", Name: "Orders"},
+	}
+
+	BeforeEach(func() {
+		methods = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			methods = append(methods, r.Method)
+			if r.Method == http.MethodGet {
+				Expect(json.NewEncoder(w).Encode(existing)).To(Succeed())
+				return
+			}
+			category := new(model.Category)
+			Expect(json.NewDecoder(r.Body).Decode(category)).To(Succeed())
+			if category.ID == "" {
+				category.ID = "created-id"
+			}
+			Expect(json.NewEncoder(w).Encode(category)).To(Succeed())
+		}))
+
+		urls, err := client.NewURLs(server.URL, "DEFAULT", "DEFAULT")
+		Expect(err).ToNot(HaveOccurred())
+
+		categories = service.NewCategories(&client.Client{
+			HTTP: xhttp.NewNoAuthClient(context.Background()),
+			URLs: urls,
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should find the references matching no category by ID, key or name", func() {
+		missing, err := categories.FindMissing([]string{"category-1", "orders", "PAYMENTS", "unknown"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(missing).To(Equal([]string{"unknown"}))
+	})
+
+	It("should not list categories when no reference is given", func() {
+		missing, err := categories.FindMissing(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(missing).To(BeEmpty())
+		Expect(methods).To(BeEmpty())
+	})
+
+	It("should create a category with a new name", func() {
+		created, err := categories.CreateOrUpdate(&model.Category{Name: "Billing"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created.ID).To(Equal("created-id"))
+		Expect(methods).To(Equal([]string{http.MethodGet, http.MethodPost}))
+	})
+
+	It("should not adopt an existing category with the same name", func() {
+		_, err := categories.CreateOrUpdate(&model.Category{Name: "payments"})
+		Expect(err).To(MatchError(ContainSubstring("category [payments] already exists with ID [category-1]")))
+		Expect(methods).To(Equal([]string{http.MethodGet}))
+	})
+
+	It("should update the category matching the given ID", func() {
+		updated, err := categories.CreateOrUpdate(&model.Category{ID: "category-1", Name: "Payments"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated.ID).To(Equal("category-1"))
+		Expect(methods).To(Equal([]string{http.MethodPut}))
+	})
+})
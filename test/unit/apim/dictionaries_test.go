@@ -0,0 +1,78 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apim_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/service"
+	xhttp "github.com/gravitee-io/gravitee-kubernetes-operator/internal/http"
+)
+
+var _ = Describe("Dictionaries", func() {
+	var server *httptest.Server
+	var dictionaries *service.Dictionaries
+	var methods []string
+
+	BeforeEach(func() {
+		methods = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			methods = append(methods, r.Method)
+			if r.Method == http.MethodGet {
+				Expect(json.NewEncoder(w).Encode([]model.Dictionary{{ID: "dictionary-1", Name: "Settings"}})).
+					To(Succeed())
+				return
+			}
+			dictionary := new(model.Dictionary)
+			Expect(json.NewDecoder(r.Body).Decode(dictionary)).To(Succeed())
+			dictionary.ID = "created-id"
+			Expect(json.NewEncoder(w).Encode(dictionary)).To(Succeed())
+		}))
+
+		urls, err := client.NewURLs(server.URL, "DEFAULT", "DEFAULT")
+		Expect(err).ToNot(HaveOccurred())
+
+		dictionaries = service.NewDictionaries(&client.Client{
+			HTTP: xhttp.NewNoAuthClient(context.Background()),
+			URLs: urls,
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should create a dictionary with a new name", func() {
+		created, err := dictionaries.CreateOrUpdate(&model.Dictionary{Name: "Regions", Type: "MANUAL"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created.ID).To(Equal("created-id"))
+		Expect(methods).To(Equal([]string{http.MethodGet, http.MethodPost}))
+	})
+
+	It("should not adopt an existing dictionary with the same name", func() {
+		_, err := dictionaries.CreateOrUpdate(&model.Dictionary{Name: "settings", Type: "MANUAL"})
+		Expect(err).To(MatchError(ContainSubstring("dictionary [settings] already exists with ID [dictionary-1]")))
+		Expect(methods).To(Equal([]string{http.MethodGet}))
+	})
+})
@@ -0,0 +1,124 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apim_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/group"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/service"
+	xhttp "github.com/gravitee-io/gravitee-kubernetes-operator/internal/http"
+)
+
+var _ = Describe("Group IDs", func() {
+	newGroup := func(uid string) *v1alpha1.Group {
+		return &v1alpha1.Group{
+			ObjectMeta: metav1.ObjectMeta{Name: "developers", Namespace: "default", UID: k8stypes.UID("uid-" + uid)},
+		}
+	}
+
+	It("should derive the same ID for a recreated group", func() {
+		mCtx := &management.Context{OrgID: "DEFAULT", EnvID: "DEFAULT"}
+
+		first, second := newGroup("1"), newGroup("2")
+		first.PopulateIDs(mCtx)
+		second.PopulateIDs(mCtx)
+
+		Expect(first.Spec.ID).ToNot(BeEmpty())
+		Expect(first.Spec.ID).ToNot(Equal(string(first.UID)))
+		Expect(second.Spec.ID).To(Equal(first.Spec.ID))
+	})
+
+	It("should derive different IDs for different environments", func() {
+		first, second := newGroup("1"), newGroup("1")
+		first.PopulateIDs(&management.Context{OrgID: "DEFAULT", EnvID: "DEV"})
+		second.PopulateIDs(&management.Context{OrgID: "DEFAULT", EnvID: "PROD"})
+
+		Expect(second.Spec.ID).ToNot(Equal(first.Spec.ID))
+	})
+
+	It("should keep the ID known by the status or set by the spec", func() {
+		mCtx := &management.Context{OrgID: "DEFAULT", EnvID: "DEFAULT"}
+
+		synced := newGroup("1")
+		synced.Status.ID = "status-id"
+		synced.Spec.ID = "spec-id"
+		synced.PopulateIDs(mCtx)
+		Expect(synced.Spec.ID).To(Equal("status-id"))
+
+		defined := newGroup("1")
+		defined.Spec.ID = "spec-id"
+		defined.PopulateIDs(mCtx)
+		Expect(defined.Spec.ID).To(Equal("spec-id"))
+	})
+})
+
+var _ = Describe("Groups", func() {
+	var server *httptest.Server
+	var groups *service.Groups
+	var method, path string
+
+	BeforeEach(func() {
+		method, path = "", ""
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			method, path = r.Method, r.URL.Path
+			if r.Method == http.MethodDelete {
+				return
+			}
+			spec := new(group.Type)
+			Expect(json.NewDecoder(r.Body).Decode(spec)).To(Succeed())
+			Expect(json.NewEncoder(w).Encode(group.Status{ID: spec.ID, Members: uint(len(spec.Members))})).
+				To(Succeed())
+		}))
+
+		urls, err := client.NewURLs(server.URL, "DEFAULT", "DEFAULT")
+		Expect(err).ToNot(HaveOccurred())
+
+		groups = service.NewGroups(&client.Client{
+			HTTP: xhttp.NewNoAuthClient(context.Background()),
+			URLs: urls,
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should import the group with its ID", func() {
+		status, err := groups.CreateOrUpdate(&group.Type{ID: "group-id", Name: "developers"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(method).To(Equal(http.MethodPut))
+		Expect(path).To(HaveSuffix("/groups/_import/crd"))
+		Expect(status.ID).To(Equal("group-id"))
+	})
+
+	It("should delete the group by ID", func() {
+		Expect(groups.Delete("group-id")).To(Succeed())
+		Expect(method).To(Equal(http.MethodDelete))
+		Expect(path).To(HaveSuffix("/groups/group-id"))
+	})
+})
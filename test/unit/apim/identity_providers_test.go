@@ -0,0 +1,161 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apim_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/service"
+	xhttp "github.com/gravitee-io/gravitee-kubernetes-operator/internal/http"
+)
+
+var _ = Describe("IdentityProviders activation", func() {
+	var server *httptest.Server
+	var providers *service.IdentityProviders
+	var activations []model.IdentityProviderActivation
+
+	BeforeEach(func() {
+		activations = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.URL.Path).To(HaveSuffix("/organizations/DEFAULT/identities"))
+			if r.Method == http.MethodGet {
+				Expect(json.NewEncoder(w).Encode([]model.IdentityProviderActivation{
+					{IdentityProvider: "github"}, {IdentityProvider: "keycloak"},
+				})).To(Succeed())
+				return
+			}
+			Expect(r.Method).To(Equal(http.MethodPut))
+			Expect(json.NewDecoder(r.Body).Decode(&activations)).To(Succeed())
+		}))
+
+		urls, err := client.NewURLs(server.URL, "DEFAULT", "DEFAULT")
+		Expect(err).ToNot(HaveOccurred())
+
+		providers = service.NewIdentityProviders(&client.Client{
+			HTTP: xhttp.NewNoAuthClient(context.Background()),
+			URLs: urls,
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should activate identity provider and keep other activations", func() {
+		Expect(providers.SetActivated("google", true)).To(Succeed())
+		Expect(activations).To(ConsistOf(
+			model.IdentityProviderActivation{IdentityProvider: "github"},
+			model.IdentityProviderActivation{IdentityProvider: "keycloak"},
+			model.IdentityProviderActivation{IdentityProvider: "google"},
+		))
+	})
+
+	It("should deactivate identity provider and keep other activations", func() {
+		Expect(providers.SetActivated("keycloak", false)).To(Succeed())
+		Expect(activations).To(ConsistOf(model.IdentityProviderActivation{IdentityProvider: "github"}))
+	})
+
+	It("should not update activations that are already in sync", func() {
+		Expect(providers.SetActivated("keycloak", true)).To(Succeed())
+		Expect(activations).To(BeNil())
+	})
+})
+
+var _ = Describe("IdentityProviders", func() {
+	var server *httptest.Server
+	var providers *service.IdentityProviders
+	var requests []string
+
+	BeforeEach(func() {
+		requests = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			requests = append(requests, r.Method+" "+r.URL.Path[strings.Index(r.URL.Path, "/configuration"):])
+			switch {
+			case r.Method == http.MethodGet:
+				Expect(json.NewEncoder(w).Encode([]model.IdentityProvider{{ID: "github", Name: "GitHub"}})).To(Succeed())
+			case strings.HasSuffix(r.URL.Path, "/deleted"):
+				w.WriteHeader(http.StatusNotFound)
+			default:
+				provider := new(model.IdentityProvider)
+				Expect(json.NewDecoder(r.Body).Decode(provider)).To(Succeed())
+				if provider.ID == "" {
+					provider.ID = "created-id"
+				}
+				Expect(json.NewEncoder(w).Encode(provider)).To(Succeed())
+			}
+		}))
+
+		urls, err := client.NewURLs(server.URL, "DEFAULT", "DEFAULT")
+		Expect(err).ToNot(HaveOccurred())
+
+		providers = service.NewIdentityProviders(&client.Client{
+			HTTP: xhttp.NewNoAuthClient(context.Background()),
+			URLs: urls,
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should update the identity provider matching the given ID without looking it up by name", func() {
+		synced, err := providers.CreateOrUpdate(&model.IdentityProvider{ID: "keycloak", Name: "GitHub", Enabled: true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(synced.ID).To(Equal("keycloak"))
+		Expect(requests).To(Equal([]string{"PUT /configuration/identities/keycloak"}))
+	})
+
+	It("should update the identity provider with the same name on first sync", func() {
+		synced, err := providers.CreateOrUpdate(&model.IdentityProvider{Name: "github", Enabled: true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(synced.ID).To(Equal("github"))
+		Expect(requests).To(Equal([]string{
+			"GET /configuration/identities",
+			"PUT /configuration/identities/github",
+		}))
+	})
+
+	It("should create the identity provider when the given ID no longer exists", func() {
+		synced, err := providers.CreateOrUpdate(&model.IdentityProvider{ID: "deleted", Name: "GitHub", Enabled: true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(synced.ID).To(Equal("created-id"))
+		Expect(requests).To(Equal([]string{
+			"PUT /configuration/identities/deleted",
+			"POST /configuration/identities",
+		}))
+	})
+
+	It("should disable the identity provider after its creation", func() {
+		synced, err := providers.CreateOrUpdate(&model.IdentityProvider{Name: "Keycloak"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(synced.ID).To(Equal("created-id"))
+		Expect(requests).To(Equal([]string{
+			"GET /configuration/identities",
+			"POST /configuration/identities",
+			"PUT /configuration/identities/created-id",
+		}))
+	})
+})
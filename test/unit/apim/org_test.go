@@ -0,0 +1,72 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apim_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/service"
+	xhttp "github.com/gravitee-io/gravitee-kubernetes-operator/internal/http"
+)
+
+var _ = Describe("Org", func() {
+	var server *httptest.Server
+	var org *service.Org
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.URL.Path).To(HaveSuffix("/organizations/DEFAULT/users"))
+			page := model.UserPage{Data: []model.User{
+				{ID: "1", Source: "gravitee", SourceID: r.URL.Query().Get("q")},
+				{ID: "2", Source: "memory", SourceID: "admin"},
+			}}
+			Expect(json.NewEncoder(w).Encode(page)).To(Succeed())
+		}))
+
+		urls, err := client.NewURLs(server.URL, "DEFAULT", "DEFAULT")
+		Expect(err).ToNot(HaveOccurred())
+
+		org = service.NewOrg(&client.Client{
+			HTTP: xhttp.NewNoAuthClient(context.Background()),
+			URLs: urls,
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should find user matching source and source ID", func() {
+		user, err := org.FindUser("MEMORY", "admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(user).ToNot(BeNil())
+		Expect(user.ID).To(Equal("2"))
+	})
+
+	It("should not find user from another source", func() {
+		user, err := org.FindUser("ldap", "admin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(user).To(BeNil())
+	})
+})
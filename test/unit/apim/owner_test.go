@@ -0,0 +1,131 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apim_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/owner"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/service"
+	xhttp "github.com/gravitee-io/gravitee-kubernetes-operator/internal/http"
+)
+
+var _ = Describe("Primary owner", func() {
+	var server *httptest.Server
+	var apimClient *apim.APIM
+	var currentOwner string
+	var transfers []map[string]any
+
+	BeforeEach(func() {
+		currentOwner, transfers = "", nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			switch {
+			case r.Method == http.MethodPost:
+				transfer := make(map[string]any)
+				Expect(json.NewDecoder(r.Body).Decode(&transfer)).To(Succeed())
+				transfer["path"] = r.URL.Path
+				transfers = append(transfers, transfer)
+			case strings.HasSuffix(r.URL.Path, "/users"):
+				Expect(json.NewEncoder(w).Encode(model.UserPage{Data: []model.User{
+					{ID: "user-id", Source: "gravitee", SourceID: "john"},
+				}})).To(Succeed())
+			case strings.HasSuffix(r.URL.Path, "/configuration/groups"):
+				Expect(json.NewEncoder(w).Encode([]model.Group{{ID: "group-id", Name: "developers"}})).To(Succeed())
+			case strings.Contains(r.URL.Path, "/applications/"):
+				Expect(json.NewEncoder(w).Encode(model.Application{
+					ID: "app-id", Owner: &model.PrimaryOwner{ID: currentOwner},
+				})).To(Succeed())
+			default:
+				Expect(json.NewEncoder(w).Encode(map[string]any{
+					"primaryOwner": model.PrimaryOwner{ID: currentOwner},
+				})).To(Succeed())
+			}
+		}))
+
+		urls, err := client.NewURLs(server.URL, "DEFAULT", "DEFAULT")
+		Expect(err).ToNot(HaveOccurred())
+
+		cli := &client.Client{HTTP: xhttp.NewNoAuthClient(context.Background()), URLs: urls}
+		apimClient = &apim.APIM{
+			APIs:         service.NewAPIs(cli),
+			Applications: service.NewApplications(cli),
+			Env:          service.NewEnv(cli),
+			Org:          service.NewOrg(cli),
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should transfer the API ownership to a user", func() {
+		po := &owner.PrimaryOwner{Type: "user", Reference: "john", FormerOwnerRole: "OWNER"}
+		Expect(apimClient.SyncApiPrimaryOwner("api-id", po)).To(Succeed())
+
+		Expect(transfers).To(HaveLen(1))
+		Expect(transfers[0]["path"]).To(HaveSuffix("/apis/api-id/_transfer-ownership"))
+		Expect(transfers[0]["userId"]).To(Equal("user-id"))
+		Expect(transfers[0]["userType"]).To(Equal(owner.TypeUser))
+		Expect(transfers[0]["poRole"]).To(Equal("OWNER"))
+	})
+
+	It("should transfer the API ownership to a group", func() {
+		po := &owner.PrimaryOwner{Type: owner.TypeGroup, Reference: "developers"}
+		Expect(apimClient.SyncApiPrimaryOwner("api-id", po)).To(Succeed())
+
+		Expect(transfers).To(HaveLen(1))
+		Expect(transfers[0]["userId"]).To(Equal("group-id"))
+		Expect(transfers[0]["userType"]).To(Equal(owner.TypeGroup))
+	})
+
+	It("should not transfer the API ownership to the current owner", func() {
+		currentOwner = "user-id"
+		Expect(apimClient.SyncApiPrimaryOwner("api-id", &owner.PrimaryOwner{Reference: "john"})).To(Succeed())
+		Expect(transfers).To(BeEmpty())
+	})
+
+	It("should fail when the primary owner does not exist", func() {
+		err := apimClient.SyncApiPrimaryOwner("api-id", &owner.PrimaryOwner{Reference: "jane"})
+		Expect(err).To(MatchError(ContainSubstring("does not exist")))
+		Expect(transfers).To(BeEmpty())
+	})
+
+	It("should transfer the application ownership", func() {
+		po := &owner.PrimaryOwner{Reference: "john", FormerOwnerRole: "USER"}
+		Expect(apimClient.SyncApplicationPrimaryOwner("app-id", po)).To(Succeed())
+
+		Expect(transfers).To(HaveLen(1))
+		Expect(transfers[0]["path"]).To(HaveSuffix("/applications/app-id/members/transfer_ownership"))
+		Expect(transfers[0]["id"]).To(Equal("user-id"))
+		Expect(transfers[0]["role"]).To(Equal("USER"))
+	})
+
+	It("should not transfer the application ownership to the current owner", func() {
+		currentOwner = "user-id"
+		Expect(apimClient.SyncApplicationPrimaryOwner("app-id", &owner.PrimaryOwner{Reference: "john"})).To(Succeed())
+		Expect(transfers).To(BeEmpty())
+	})
+})
@@ -0,0 +1,194 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apim_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/service"
+	xhttp "github.com/gravitee-io/gravitee-kubernetes-operator/internal/http"
+)
+
+var _ = Describe("Portal menu links", func() {
+	var server *httptest.Server
+	var portal *service.Portal
+	var requests []string
+
+	BeforeEach(func() {
+		requests = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			requests = append(requests, r.Method+" "+r.URL.Path[strings.Index(r.URL.Path, "/ui/"):])
+			switch r.Method {
+			case http.MethodGet:
+				Expect(json.NewEncoder(w).Encode(model.PortalMenuLinkPage{Data: []model.PortalMenuLink{
+					{ID: "link-1", Name: "Docs"}, {ID: "link-2", Name: "Blog"}, {ID: "foreign", Name: "Status"},
+				}})).To(Succeed())
+			case http.MethodDelete:
+				return
+			default:
+				link := new(model.PortalMenuLink)
+				Expect(json.NewDecoder(r.Body).Decode(link)).To(Succeed())
+				if link.Name == "Broken" {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				if link.ID == "" {
+					link.ID = "created-" + strings.ToLower(link.Name)
+				}
+				Expect(json.NewEncoder(w).Encode(link)).To(Succeed())
+			}
+		}))
+
+		urls, err := client.NewURLs(server.URL, "DEFAULT", "DEFAULT")
+		Expect(err).ToNot(HaveOccurred())
+
+		portal = service.NewPortal(&client.Client{
+			HTTP: xhttp.NewNoAuthClient(context.Background()),
+			URLs: urls,
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should update managed links, create new ones and delete the ones no longer declared", func() {
+		synced, err := portal.SyncMenuLinks([]*model.PortalMenuLink{
+			{Name: "Docs", Target: "https://docs.example.com", Order: 1},
+			{Name: "Support", Target: "https://support.example.com", Order: 2},
+		}, map[string]string{"Docs": "link-1", "Blog": "link-2", "Gone": "deleted-in-apim"})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(synced).To(Equal(map[string]string{"Docs": "link-1", "Support": "created-support"}))
+		Expect(requests).To(Equal([]string{
+			"GET /ui/portal-menu-links",
+			"PUT /ui/portal-menu-links/link-1",
+			"POST /ui/portal-menu-links",
+			"DELETE /ui/portal-menu-links/link-2",
+		}))
+	})
+
+	It("should recreate a managed link that has been deleted in APIM", func() {
+		synced, err := portal.SyncMenuLinks([]*model.PortalMenuLink{
+			{Name: "Gone", Target: "https://example.com"},
+		}, map[string]string{"Gone": "deleted-in-apim"})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(synced).To(Equal(map[string]string{"Gone": "created-gone"}))
+	})
+
+	It("should keep track of the managed links when a link cannot be synced", func() {
+		synced, err := portal.SyncMenuLinks([]*model.PortalMenuLink{
+			{Name: "Support", Target: "https://support.example.com"},
+			{Name: "Broken", Target: "https://example.com"},
+		}, map[string]string{"Blog": "link-2"})
+
+		Expect(err).To(HaveOccurred())
+		Expect(synced).To(Equal(map[string]string{"Blog": "link-2", "Support": "created-support"}))
+	})
+})
+
+var _ = Describe("Portal pages", func() {
+	var server *httptest.Server
+	var portal *service.Portal
+	var requests []string
+
+	BeforeEach(func() {
+		requests = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			requests = append(requests, r.Method+" "+r.URL.Path[strings.Index(r.URL.Path, "/portal/"):])
+			switch r.Method {
+			case http.MethodGet:
+				pages := []model.PortalPage{}
+				if r.URL.Query().Get("name") == "Guide" && r.URL.Query().Get("root") == "true" {
+					pages = append(pages, model.PortalPage{ID: "page-1", Name: "Guide"})
+				}
+				Expect(json.NewEncoder(w).Encode(pages)).To(Succeed())
+			default:
+				if strings.HasSuffix(r.URL.Path, "/deleted-in-apim") {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				page := new(model.PortalPage)
+				Expect(json.NewDecoder(r.Body).Decode(page)).To(Succeed())
+				if page.ID == "" {
+					page.ID = "created-" + strings.ToLower(page.Name)
+				}
+				Expect(json.NewEncoder(w).Encode(page)).To(Succeed())
+			}
+		}))
+
+		urls, err := client.NewURLs(server.URL, "DEFAULT", "DEFAULT")
+		Expect(err).ToNot(HaveOccurred())
+
+		portal = service.NewPortal(&client.Client{
+			HTTP: xhttp.NewNoAuthClient(context.Background()),
+			URLs: urls,
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should create a page with a new name", func() {
+		created, err := portal.CreateOrUpdatePage(&model.PortalPage{Name: "Tutorial"})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created.ID).To(Equal("created-tutorial"))
+		Expect(requests).To(Equal([]string{"GET /portal/pages", "POST /portal/pages"}))
+	})
+
+	It("should not adopt an existing page with the same name under the same parent", func() {
+		_, err := portal.CreateOrUpdatePage(&model.PortalPage{Name: "Guide"})
+
+		Expect(err).To(MatchError(ContainSubstring("portal page [Guide] already exists with ID [page-1]")))
+		Expect(requests).To(Equal([]string{"GET /portal/pages"}))
+	})
+
+	It("should create a page with the same name under another parent", func() {
+		created, err := portal.CreateOrUpdatePage(&model.PortalPage{Name: "Guide", ParentID: "folder-1"})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created.ID).To(Equal("created-guide"))
+	})
+
+	It("should update the page matching the given ID without looking it up by name", func() {
+		updated, err := portal.CreateOrUpdatePage(&model.PortalPage{ID: "page-1", Name: "Guide"})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated.ID).To(Equal("page-1"))
+		Expect(requests).To(Equal([]string{"PUT /portal/pages/page-1"}))
+	})
+
+	It("should recreate a page that has been deleted in APIM", func() {
+		created, err := portal.CreateOrUpdatePage(&model.PortalPage{ID: "deleted-in-apim", Name: "Tutorial"})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created.ID).To(Equal("created-tutorial"))
+		Expect(requests).To(Equal([]string{"PUT /portal/pages/deleted-in-apim", "POST /portal/pages"}))
+	})
+})
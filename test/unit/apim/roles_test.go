@@ -0,0 +1,85 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apim_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/service"
+	xhttp "github.com/gravitee-io/gravitee-kubernetes-operator/internal/http"
+)
+
+var _ = Describe("Roles", func() {
+	var server *httptest.Server
+	var roles *service.Roles
+	var method, path string
+
+	BeforeEach(func() {
+		method, path = "", ""
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			if r.Method == http.MethodGet {
+				Expect(r.URL.Path).To(HaveSuffix("/organizations/DEFAULT/configuration/rolescopes/API/roles"))
+				Expect(json.NewEncoder(w).Encode([]model.Role{{ID: "reviewer-id", Name: "REVIEWER", Scope: "API"}})).
+					To(Succeed())
+				return
+			}
+			method, path = r.Method, r.URL.Path
+			role := new(model.RoleDefinition)
+			Expect(json.NewDecoder(r.Body).Decode(role)).To(Succeed())
+			if role.ID == "" {
+				role.ID = "auditor-id"
+			}
+			Expect(json.NewEncoder(w).Encode(model.Role{ID: role.ID, Name: role.Name, Scope: role.Scope})).
+				To(Succeed())
+		}))
+
+		urls, err := client.NewURLs(server.URL, "DEFAULT", "DEFAULT")
+		Expect(err).ToNot(HaveOccurred())
+
+		roles = service.NewRoles(&client.Client{
+			HTTP: xhttp.NewNoAuthClient(context.Background()),
+			URLs: urls,
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should update role with the same scope and name", func() {
+		role, err := roles.CreateOrUpdate(&model.RoleDefinition{Name: "reviewer", Scope: "API"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(method).To(Equal(http.MethodPut))
+		Expect(path).To(HaveSuffix("/rolescopes/API/roles/REVIEWER"))
+		Expect(role.ID).To(Equal("reviewer-id"))
+	})
+
+	It("should create unknown role", func() {
+		role, err := roles.CreateOrUpdate(&model.RoleDefinition{Name: "AUDITOR", Scope: "API"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(method).To(Equal(http.MethodPost))
+		Expect(path).To(HaveSuffix("/rolescopes/API/roles"))
+		Expect(role.ID).To(Equal("auditor-id"))
+	})
+})
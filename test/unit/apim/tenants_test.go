@@ -0,0 +1,87 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apim_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/client"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/model"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/apim/service"
+	xhttp "github.com/gravitee-io/gravitee-kubernetes-operator/internal/http"
+)
+
+var _ = Describe("Tenants", func() {
+	var server *httptest.Server
+	var tenants *service.Tenants
+	var method string
+
+	BeforeEach(func() {
+		method = ""
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.URL.Path).To(HaveSuffix("/organizations/DEFAULT/configuration/tenants"))
+			if r.Method == http.MethodGet {
+				Expect(json.NewEncoder(w).Encode([]model.Tenant{{ID: "eu-west", Name: "EU West"}})).To(Succeed())
+				return
+			}
+			method = r.Method
+			synced := make([]model.Tenant, 0)
+			Expect(json.NewDecoder(r.Body).Decode(&synced)).To(Succeed())
+			if synced[0].ID == "" {
+				synced[0].ID = "us-east"
+			}
+			Expect(json.NewEncoder(w).Encode(synced)).To(Succeed())
+		}))
+
+		urls, err := client.NewURLs(server.URL, "DEFAULT", "DEFAULT")
+		Expect(err).ToNot(HaveOccurred())
+
+		tenants = service.NewTenants(&client.Client{
+			HTTP: xhttp.NewNoAuthClient(context.Background()),
+			URLs: urls,
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should update tenant with the same name", func() {
+		tenant, err := tenants.CreateOrUpdate(&model.Tenant{Name: "eu west"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(method).To(Equal(http.MethodPut))
+		Expect(tenant.ID).To(Equal("eu-west"))
+	})
+
+	It("should create unknown tenant", func() {
+		tenant, err := tenants.CreateOrUpdate(&model.Tenant{Name: "US East"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(method).To(Equal(http.MethodPost))
+		Expect(tenant.ID).To(Equal("us-east"))
+	})
+
+	It("should find missing tenants", func() {
+		missing, err := tenants.FindMissing([]string{"eu-west", "us-east"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(missing).To(Equal([]string{"us-east"}))
+	})
+})
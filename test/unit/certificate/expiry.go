@@ -0,0 +1,123 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificate_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/certificate"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Certificate expiry", func() {
+	now := time.Now()
+	thresholds := []time.Duration{720 * time.Hour, 168 * time.Hour, 24 * time.Hour}
+
+	It("should find the certificates of a secret", func() {
+		crt, _ := newKeyPair("foo.example.com", now.Add(-time.Hour), now.Add(48*time.Hour))
+		secret := &v1.Secret{Data: map[string][]byte{v1.TLSCertKey: crt, "other": []byte("not a pem")}}
+
+		infos := certificate.Find(secret)
+		Expect(infos).To(HaveLen(1))
+		Expect(infos[0].Key).To(Equal(v1.TLSCertKey))
+		Expect(infos[0].Subject).To(Equal("CN=foo.example.com"))
+	})
+
+	It("should not warn before the first threshold", func() {
+		info := certificate.Info{NotBefore: now.Add(-time.Hour), NotAfter: now.Add(1000 * time.Hour)}
+
+		warning, next := certificate.CheckExpiry(info, thresholds, now)
+		Expect(warning).To(BeNil())
+		Expect(next).To(Equal(280 * time.Hour))
+	})
+
+	It("should warn when a threshold is reached", func() {
+		info := certificate.Info{NotBefore: now.Add(-time.Hour), NotAfter: now.Add(100 * time.Hour)}
+
+		warning, next := certificate.CheckExpiry(info, thresholds, now)
+		Expect(warning).ToNot(BeNil())
+		Expect(warning.Reason).To(Equal(certificate.ExpiringReason))
+		Expect(next).To(Equal(76 * time.Hour))
+	})
+
+	It("should warn when the certificate is expired", func() {
+		info := certificate.Info{NotBefore: now.Add(-48 * time.Hour), NotAfter: now.Add(-time.Hour)}
+
+		warning, next := certificate.CheckExpiry(info, thresholds, now)
+		Expect(warning).ToNot(BeNil())
+		Expect(warning.Reason).To(Equal(certificate.ExpiredReason))
+		Expect(next).To(BeZero())
+	})
+
+	It("should parse expiry thresholds from the longest to the shortest", func() {
+		parsed, err := env.ParseDurations("24h, 720h,168h")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed).To(Equal(thresholds))
+	})
+
+	It("should reject invalid expiry thresholds", func() {
+		_, err := env.ParseDurations("720h,one week")
+		Expect(err).To(HaveOccurred())
+
+		_, err = env.ParseDurations("720h,-24h")
+		Expect(err).To(MatchError(ContainSubstring("must be positive")))
+	})
+
+	It("should accept a valid key pair", func() {
+		crt, key := newKeyPair("foo.example.com", now.Add(-time.Hour), now.Add(time.Hour))
+		Expect(certificate.ValidateKeyPair(crt, key, now)).To(Succeed())
+	})
+
+	It("should reject an expired key pair", func() {
+		crt, key := newKeyPair("foo.example.com", now.Add(-2*time.Hour), now.Add(-time.Hour))
+		Expect(certificate.ValidateKeyPair(crt, key, now)).To(MatchError(ContainSubstring("expired on")))
+	})
+
+	It("should reject a mismatched key pair", func() {
+		crt, _ := newKeyPair("foo.example.com", now.Add(-time.Hour), now.Add(time.Hour))
+		_, key := newKeyPair("bar.example.com", now.Add(-time.Hour), now.Add(time.Hour))
+		Expect(certificate.ValidateKeyPair(crt, key, now)).To(MatchError(ContainSubstring("invalid key pair")))
+	})
+})
+
+func newKeyPair(cn string, notBefore, notAfter time.Time) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	Expect(err).ToNot(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
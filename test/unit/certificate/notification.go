@@ -0,0 +1,68 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificate_test
+
+import (
+	"time"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/certificate"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Certificate expiry notification", func() {
+	now := time.Now()
+	thresholds := []time.Duration{720 * time.Hour, 168 * time.Hour, 24 * time.Hour}
+
+	It("should notify once per threshold crossing", func() {
+		info := certificate.Info{Key: "tls.crt", NotBefore: now.Add(-time.Hour), NotAfter: now.Add(100 * time.Hour)}
+
+		warning, _ := certificate.CheckExpiry(info, thresholds, now)
+		Expect(certificate.ShouldNotify("ns-1", "secret", info, warning)).To(BeTrue())
+		Expect(certificate.ShouldNotify("ns-1", "secret", info, warning)).To(BeFalse())
+
+		warning, _ = certificate.CheckExpiry(info, thresholds, now.Add(80*time.Hour))
+		Expect(warning.Threshold).To(Equal(24 * time.Hour))
+		Expect(certificate.ShouldNotify("ns-1", "secret", info, warning)).To(BeTrue())
+		Expect(certificate.ShouldNotify("ns-1", "secret", info, warning)).To(BeFalse())
+
+		warning, _ = certificate.CheckExpiry(info, thresholds, now.Add(101*time.Hour))
+		Expect(warning.Reason).To(Equal(certificate.ExpiredReason))
+		Expect(certificate.ShouldNotify("ns-1", "secret", info, warning)).To(BeTrue())
+	})
+
+	It("should notify again once the certificate has been renewed", func() {
+		info := certificate.Info{Key: "tls.crt", NotBefore: now.Add(-time.Hour), NotAfter: now.Add(100 * time.Hour)}
+		warning, _ := certificate.CheckExpiry(info, thresholds, now)
+		Expect(certificate.ShouldNotify("ns-2", "secret", info, warning)).To(BeTrue())
+
+		renewed := certificate.Info{Key: "tls.crt", NotBefore: now, NotAfter: now.Add(2000 * time.Hour)}
+		certificate.Retain("ns-2", "secret", []certificate.Info{renewed})
+		warning, _ = certificate.CheckExpiry(renewed, thresholds, now)
+		Expect(certificate.ShouldNotify("ns-2", "secret", renewed, warning)).To(BeFalse())
+
+		warning, _ = certificate.CheckExpiry(renewed, thresholds, now.Add(1500*time.Hour))
+		Expect(certificate.ShouldNotify("ns-2", "secret", renewed, warning)).To(BeTrue())
+	})
+
+	It("should notify again when the secret is no longer monitored", func() {
+		info := certificate.Info{Key: "tls.crt", NotBefore: now.Add(-time.Hour), NotAfter: now.Add(-time.Minute)}
+		warning, _ := certificate.CheckExpiry(info, thresholds, now)
+		Expect(certificate.ShouldNotify("ns-3", "secret", info, warning)).To(BeTrue())
+
+		certificate.Retain("ns-3", "secret", nil)
+		Expect(certificate.ShouldNotify("ns-3", "secret", info, warning)).To(BeTrue())
+	})
+})
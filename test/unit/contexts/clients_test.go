@@ -0,0 +1,62 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contexts
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s/dynamic"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// indexedCache registers the indexers of the operator on a fake client builder,
+// other cache operations are not supported.
+type indexedCache struct {
+	cache.Cache
+	builder *fake.ClientBuilder
+}
+
+func (c *indexedCache) IndexField(_ context.Context, obj client.Object, field string, fn client.IndexerFunc) error {
+	c.builder.WithIndex(obj, field, fn)
+	return nil
+}
+
+// registerFakeClients makes the given objects available to both the client and the dynamic client,
+// the client supporting the field selectors of the operator indexes.
+func registerFakeClients(objects ...client.Object) {
+	scheme := runtime.NewScheme()
+	Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	dynamicObjects := make([]runtime.Object, 0, len(objects))
+	for _, obj := range objects {
+		dynamicObjects = append(dynamicObjects, obj.DeepCopyObject())
+	}
+
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...)
+	Expect(indexer.InitCache(context.Background(), &indexedCache{builder: builder})).To(Succeed())
+
+	k8s.RegisterClient(builder.Build())
+	dynamic.RegisterClient(dynamicfake.NewSimpleDynamicClient(scheme, dynamicObjects...))
+}
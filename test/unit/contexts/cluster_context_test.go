@@ -0,0 +1,56 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contexts
+
+import (
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("cluster context reference", func() {
+	It("is not defaulted to the namespace of the referencing resource", func() {
+		ref := &refs.NamespacedName{Name: "shared-ctx", Kind: core.CRDClusterManagementContextKind}
+
+		Expect(ref.IsMissingNamespace()).To(BeFalse())
+		ref.SetNamespace("apis")
+
+		Expect(ref.GetNamespace()).To(BeEmpty())
+		Expect(ref.String()).To(Equal("/shared-ctx"))
+	})
+
+	It("ignores the namespace of the reference", func() {
+		ref := &refs.NamespacedName{Name: "shared-ctx", Namespace: "apim", Kind: core.CRDClusterManagementContextKind}
+
+		Expect(ref.NamespacedName().Namespace).To(BeEmpty())
+		Expect(ref.String()).To(Equal("/shared-ctx"))
+	})
+
+	It("is kept as a cluster reference by the API deployed to the context", func() {
+		api := newAPI()
+		api.Spec.Contexts = append(api.Spec.Contexts, v4.ContextTarget{
+			NamespacedName: refs.NamespacedName{Name: "shared-ctx", Kind: core.CRDClusterManagementContextKind},
+		})
+		api.Status.Contexts = []v4.ContextStatus{{Context: "/shared-ctx", ID: "api-id"}}
+
+		target := api.ForContext(&api.Spec.Contexts[2])
+
+		Expect(target.Spec.Context.GetKind()).To(Equal(core.CRDClusterManagementContextKind))
+		Expect(target.Spec.Context.String()).To(Equal("/shared-ctx"))
+		Expect(target.Status.ID).To(Equal("api-id"))
+	})
+})
@@ -0,0 +1,83 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contexts
+
+import (
+	"context"
+
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/search"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newCategory(namespace string, ctxRef *refs.NamespacedName) *v1alpha1.Category {
+	return &v1alpha1.Category{
+		ObjectMeta: metav1.ObjectMeta{Name: "payments", Namespace: namespace},
+		Spec:       v1alpha1.CategorySpec{Context: ctxRef},
+	}
+}
+
+var _ = Describe("context deletion", func() {
+	clusterRef := &refs.NamespacedName{Name: "shared-ctx", Kind: core.CRDClusterManagementContextKind}
+
+	It("is refused while an API is deployed to the cluster context", func() {
+		api := newAPI()
+		api.Spec.Contexts = append(api.Spec.Contexts, v4.ContextTarget{NamespacedName: *clusterRef})
+		registerFakeClients(api)
+
+		err := search.AssertContextUnused(context.Background(), newClusterContext(nil))
+
+		Expect(err).To(MatchError("can not delete shared-ctx because 1 api(s) relying on this context"))
+	})
+
+	It("is refused while a resource references the cluster context", func() {
+		registerFakeClients(newCategory("team-a", clusterRef))
+
+		err := search.AssertContextUnused(context.Background(), newClusterContext(nil))
+
+		Expect(err).To(MatchError(
+			"can not delete shared-ctx because 1 category resource(s) are relying on this context",
+		))
+	})
+
+	It("is refused while a resource references the management context", func() {
+		registerFakeClients(newCategory("team-a", &refs.NamespacedName{Name: "dev-ctx", Namespace: "apim"}))
+
+		err := search.AssertContextUnused(context.Background(), &v1alpha1.ManagementContext{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev-ctx", Namespace: "apim"},
+		})
+
+		Expect(err).To(MatchError(
+			"can not delete dev-ctx because 1 category resource(s) are relying on this context",
+		))
+	})
+
+	It("is allowed when only a namespaced context of the same name is referenced", func() {
+		registerFakeClients(newCategory("team-a", &refs.NamespacedName{Name: "shared-ctx", Namespace: "team-a"}))
+
+		Expect(search.AssertContextUnused(context.Background(), newClusterContext(nil))).To(Succeed())
+	})
+
+	It("is allowed when nothing references the context", func() {
+		registerFakeClients()
+
+		Expect(search.AssertContextUnused(context.Background(), newClusterContext(nil))).To(Succeed())
+	})
+})
@@ -0,0 +1,115 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contexts
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s/dynamic"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const operatorNamespace = "gko-system"
+
+func newClusterContext(selector *metav1.LabelSelector) *v1alpha1.ClusterManagementContext {
+	return &v1alpha1.ClusterManagementContext{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared-ctx"},
+		Spec: v1alpha1.ClusterManagementContextSpec{Context: &management.Context{
+			BaseUrl:           "http://apim.example.com",
+			OrgID:             "DEFAULT",
+			EnvID:             "DEFAULT",
+			Auth:              &management.Auth{SecretRef: &refs.NamespacedName{Name: "apim-credentials"}},
+			NamespaceSelector: selector,
+		}},
+	}
+}
+
+func newCredentials(namespace, token string) *coreV1.Secret {
+	return &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "apim-credentials", Namespace: namespace},
+		Data:       map[string][]byte{core.BearerTokenSecretKey: []byte(token)},
+	}
+}
+
+func newNamespace(name string, labels map[string]string) *coreV1.Namespace {
+	return &coreV1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+var _ = Describe("cluster context resolution", func() {
+	ref := &refs.NamespacedName{Name: "shared-ctx", Kind: core.CRDClusterManagementContextKind}
+
+	BeforeEach(func() {
+		namespace := env.Config.OperatorNS
+		env.Config.OperatorNS = operatorNamespace
+		DeferCleanup(func() { env.Config.OperatorNS = namespace })
+	})
+
+	It("reads the secret of the context from the namespace of the operator", func() {
+		registerFakeClients(
+			newClusterContext(nil),
+			newCredentials(operatorNamespace, "operator-token"),
+			newCredentials("team-a", "tenant-token"),
+		)
+
+		mCtx, err := dynamic.ResolveContext(context.Background(), ref, "team-a")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mCtx.Auth.BearerToken).To(Equal("operator-token"))
+	})
+
+	It("fails when the secret is only found in the namespace of the resource", func() {
+		registerFakeClients(newClusterContext(nil), newCredentials("team-a", "tenant-token"))
+
+		_, err := dynamic.ResolveContext(context.Background(), ref, "team-a")
+
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("is resolved from a namespace matching the selector of the context", func() {
+		selector := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}}
+		registerFakeClients(
+			newClusterContext(selector),
+			newCredentials(operatorNamespace, "operator-token"),
+			newNamespace("team-b", map[string]string{"team": "payments"}),
+		)
+
+		mCtx, err := dynamic.ResolveContext(context.Background(), ref, "team-b")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mCtx.Auth.BearerToken).To(Equal("operator-token"))
+	})
+
+	It("is not resolved from a namespace not matching the selector of the context", func() {
+		selector := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}}
+		registerFakeClients(
+			newClusterContext(selector),
+			newCredentials(operatorNamespace, "operator-token"),
+			newNamespace("team-b", map[string]string{"team": "orders"}),
+		)
+
+		_, err := dynamic.ResolveContext(context.Background(), ref, "team-b")
+
+		Expect(dynamic.IsNamespaceNotAllowed(err)).To(BeTrue())
+		Expect(err).To(MatchError("namespace [team-b] is not allowed to reference management context [shared-ctx]"))
+	})
+})
@@ -0,0 +1,131 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contexts
+
+import (
+	"testing"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestContexts(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "package contexts")
+}
+
+func newAPI() *v1alpha1.ApiV4Definition {
+	return &v1alpha1.ApiV4Definition{
+		ObjectMeta: metav1.ObjectMeta{Name: "echo", Namespace: "apis"},
+		Spec: v1alpha1.ApiV4DefinitionSpec{
+			Api: v4.Api{
+				ApiBase: &base.ApiBase{
+					Name:  "echo",
+					State: base.StateStarted,
+					Properties: []*base.Property{
+						{Key: "tier", Value: "dev"},
+						{Key: "owner", Value: "team-a"},
+					},
+				},
+				EndpointGroups: []*v4.EndpointGroup{
+					{Name: "default", Type: "http-proxy"},
+				},
+				Plans: map[string]*v4.Plan{
+					"keyless": {Plan: &base.Plan{}},
+					"api-key": {Plan: &base.Plan{}},
+				},
+			},
+			Contexts: []v4.ContextTarget{
+				{NamespacedName: refs.NamespacedName{Name: "dev-ctx"}},
+				{
+					NamespacedName: refs.NamespacedName{Name: "prod-ctx", Namespace: "apim"},
+					EndpointGroups: []*v4.EndpointGroup{
+						{Name: "default", Type: "http-proxy", Endpoints: []*v4.Endpoint{{Name: "prod"}}},
+						{Name: "fallback", Type: "http-proxy"},
+					},
+					Properties:    []*base.Property{{Key: "tier", Value: "prod"}},
+					DisabledPlans: []string{"keyless"},
+					State:         base.StateStopped,
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("ForContext", func() {
+	It("keeps the API definition when the context does not override it", func() {
+		api := newAPI()
+		target := api.ForContext(&api.Spec.Contexts[0])
+
+		Expect(target.Spec.Context.String()).To(Equal("apis/dev-ctx"))
+		Expect(target.Spec.EndpointGroups).To(Equal(api.Spec.EndpointGroups))
+		Expect(target.Spec.Properties).To(Equal(api.Spec.Properties))
+		Expect(target.Spec.Plans).To(HaveLen(2))
+		Expect(target.Spec.State).To(Equal(base.StateStarted))
+	})
+
+	It("applies the overrides of the context", func() {
+		api := newAPI()
+		target := api.ForContext(&api.Spec.Contexts[1])
+
+		Expect(target.Spec.Context.String()).To(Equal("apim/prod-ctx"))
+		Expect(target.Spec.EndpointGroups).To(HaveLen(2))
+		Expect(target.Spec.EndpointGroups[0].Endpoints).To(HaveLen(1))
+		Expect(target.Spec.EndpointGroups[1].Name).To(Equal("fallback"))
+		Expect(target.Spec.Properties).To(ConsistOf(
+			&base.Property{Key: "tier", Value: "prod"},
+			&base.Property{Key: "owner", Value: "team-a"},
+		))
+		Expect(target.Spec.Plans).To(HaveKey("api-key"))
+		Expect(target.Spec.Plans).NotTo(HaveKey("keyless"))
+		Expect(target.Spec.State).To(Equal(base.StateStopped))
+
+		Expect(api.Spec.Properties[0].Value).To(Equal("dev"))
+		Expect(api.Spec.Plans).To(HaveLen(2))
+	})
+
+	It("generates distinct IDs for each context", func() {
+		api := newAPI()
+		dev := api.ForContext(&api.Spec.Contexts[0])
+		prod := api.ForContext(&api.Spec.Contexts[1])
+
+		dev.PopulateIDs(&management.Context{OrgID: "DEFAULT", EnvID: "DEV"})
+		prod.PopulateIDs(&management.Context{OrgID: "DEFAULT", EnvID: "PROD"})
+
+		Expect(dev.Spec.ID).NotTo(Equal(prod.Spec.ID))
+		Expect(dev.Spec.CrossID).To(Equal(prod.Spec.CrossID))
+		Expect(dev.Spec.Plans["api-key"].ID).NotTo(Equal(prod.Spec.Plans["api-key"].ID))
+	})
+
+	It("reuses the IDs known for the context", func() {
+		api := newAPI()
+		api.Status.Contexts = []v4.ContextStatus{
+			{Context: "apim/prod-ctx", ID: "api-id", CrossID: "cross-id", Plans: map[string]string{"api-key": "plan-id"}},
+		}
+
+		prod := api.ForContext(&api.Spec.Contexts[1])
+		prod.PopulateIDs(&management.Context{OrgID: "DEFAULT", EnvID: "PROD"})
+
+		Expect(prod.Spec.ID).To(Equal("api-id"))
+		Expect(prod.Spec.CrossID).To(Equal("cross-id"))
+		Expect(prod.Spec.Plans["api-key"].ID).To(Equal("plan-id"))
+	})
+})
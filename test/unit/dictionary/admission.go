@@ -0,0 +1,106 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dictionary
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/dictionary"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	admission "github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/dictionary"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s/dynamic"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Validate dictionary", func() {
+	ctx := context.Background()
+	ctrl := admission.AdmissionCtrl{}
+
+	newDictionary := func(source *dictionary.PropertiesSource) *v1alpha1.Dictionary {
+		return &v1alpha1.Dictionary{
+			ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default"},
+			Spec: v1alpha1.DictionarySpec{
+				Type: dictionary.Type{
+					Name:           "settings",
+					Kind:           dictionary.Manual,
+					PropertiesFrom: []*dictionary.PropertiesSource{source},
+				},
+				Context: &refs.NamespacedName{Name: "dev-ctx"},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		k8s.RegisterClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&coreV1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "default"},
+				Data:       map[string][]byte{"token": []byte("s3cr3t")},
+			},
+			&coreV1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "kube-system"},
+				Data:       map[string][]byte{"token": []byte("cluster-token")},
+			},
+		).Build())
+		dynamic.RegisterClient(dynamicfake.NewSimpleDynamicClient(scheme, &v1alpha1.ManagementContext{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev-ctx", Namespace: "default"},
+			Spec: v1alpha1.ManagementContextSpec{Context: &management.Context{
+				BaseUrl: "http://apim.example.com", OrgID: "DEFAULT", EnvID: "DEFAULT",
+			}},
+		}))
+	})
+
+	AfterEach(func() {
+		k8s.RegisterClient(nil)
+	})
+
+	It("accepts a source in the namespace of the dictionary", func() {
+		_, err := ctrl.ValidateCreate(ctx, newDictionary(&dictionary.PropertiesSource{
+			Secret: &refs.NamespacedName{Name: "credentials", Namespace: "default"},
+		}))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("rejects a secret in another namespace", func() {
+		_, err := ctrl.ValidateCreate(ctx, newDictionary(&dictionary.PropertiesSource{
+			Secret: &refs.NamespacedName{Name: "credentials", Namespace: "kube-system"},
+		}))
+		Expect(err).To(MatchError(ContainSubstring(
+			"secret [kube-system/credentials] must be in the namespace of the dictionary [default]",
+		)))
+	})
+
+	It("rejects a config map in another namespace", func() {
+		_, err := ctrl.ValidateCreate(ctx, newDictionary(&dictionary.PropertiesSource{
+			ConfigMap: &refs.NamespacedName{Name: "settings", Namespace: "kube-system"},
+		}))
+		Expect(err).To(MatchError(ContainSubstring(
+			"config map [kube-system/settings] must be in the namespace of the dictionary [default]",
+		)))
+	})
+})
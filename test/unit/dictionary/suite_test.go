@@ -0,0 +1,136 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dictionary
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/dictionary"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	properties "github.com/gravitee-io/gravitee-kubernetes-operator/internal/dictionary"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDictionary(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "package dictionary")
+}
+
+var _ = Describe("ResolveProperties", func() {
+	ctx := context.Background()
+
+	newDictionary := func(kind dictionary.Kind, sources ...*dictionary.PropertiesSource) *v1alpha1.Dictionary {
+		return &v1alpha1.Dictionary{
+			ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default"},
+			Spec: v1alpha1.DictionarySpec{Type: dictionary.Type{
+				Kind:           kind,
+				Properties:     map[string]string{"region": "inline"},
+				PropertiesFrom: sources,
+			}},
+		}
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+
+		k8s.RegisterClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&coreV1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: "default"},
+				Data:       map[string]string{"region": "config-map", "timeout": "config-map", "retries": "3"},
+				BinaryData: map[string][]byte{"banner": []byte("hello")},
+			},
+			&coreV1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "default"},
+				Data:       map[string][]byte{"timeout": []byte("secret"), "token": []byte("s3cr3t")},
+			},
+			&coreV1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "kube-system"},
+				Data:       map[string][]byte{"token": []byte("cluster-token")},
+			},
+		).Build())
+	})
+
+	AfterEach(func() {
+		k8s.RegisterClient(nil)
+	})
+
+	It("gives precedence to later sources and then to inline properties", func() {
+		dict := newDictionary(dictionary.Manual,
+			&dictionary.PropertiesSource{ConfigMap: &refs.NamespacedName{Name: "defaults"}},
+			&dictionary.PropertiesSource{Secret: &refs.NamespacedName{Name: "credentials"}},
+		)
+
+		props, err := properties.ResolveProperties(ctx, dict)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(props).To(Equal(map[string]string{
+			"region":  "inline",
+			"timeout": "secret",
+			"retries": "3",
+			"banner":  "hello",
+			"token":   "s3cr3t",
+		}))
+	})
+
+	It("gives precedence to earlier sources when they come last", func() {
+		dict := newDictionary(dictionary.Manual,
+			&dictionary.PropertiesSource{Secret: &refs.NamespacedName{Name: "credentials"}},
+			&dictionary.PropertiesSource{ConfigMap: &refs.NamespacedName{Name: "defaults"}},
+		)
+
+		props, err := properties.ResolveProperties(ctx, dict)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(props).To(HaveKeyWithValue("timeout", "config-map"))
+		Expect(props).To(HaveKeyWithValue("region", "inline"))
+	})
+
+	It("resolves sources in the namespace of the dictionary only", func() {
+		dict := newDictionary(dictionary.Manual,
+			&dictionary.PropertiesSource{Secret: &refs.NamespacedName{Name: "credentials", Namespace: "kube-system"}},
+		)
+
+		props, err := properties.ResolveProperties(ctx, dict)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(props).To(HaveKeyWithValue("token", "s3cr3t"))
+	})
+
+	It("fails on a source missing from the namespace of the dictionary", func() {
+		dict := newDictionary(dictionary.Manual,
+			&dictionary.PropertiesSource{ConfigMap: &refs.NamespacedName{Name: "unknown"}},
+		)
+
+		_, err := properties.ResolveProperties(ctx, dict)
+		Expect(err).To(MatchError(ContainSubstring("unable to read dictionary properties from config map")))
+	})
+
+	It("ignores the properties of a dynamic dictionary", func() {
+		dict := newDictionary(dictionary.Dynamic,
+			&dictionary.PropertiesSource{ConfigMap: &refs.NamespacedName{Name: "defaults"}},
+		)
+
+		props, err := properties.ResolveProperties(ctx, dict)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(props).To(BeNil())
+	})
+})
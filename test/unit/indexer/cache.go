@@ -0,0 +1,42 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// fieldCache records the indexers registered by the operator, other cache operations are not supported.
+type fieldCache struct {
+	cache.Cache
+	indexers map[string]client.IndexerFunc
+}
+
+func (c *fieldCache) IndexField(_ context.Context, _ client.Object, field string, fn client.IndexerFunc) error {
+	c.indexers[field] = fn
+	return nil
+}
+
+func index(field indexer.IndexField, obj client.Object) []string {
+	c := &fieldCache{indexers: make(map[string]client.IndexerFunc)}
+	Expect(indexer.InitCache(context.Background(), c)).To(Succeed())
+	Expect(c.indexers).To(HaveKey(string(field)))
+	return c.indexers[string(field)](obj)
+}
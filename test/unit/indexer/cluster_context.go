@@ -0,0 +1,55 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/env"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Cluster context indexers", func() {
+	BeforeEach(func() {
+		namespace := env.Config.OperatorNS
+		env.Config.OperatorNS = "gko-system"
+		DeferCleanup(func() { env.Config.OperatorNS = namespace })
+	})
+
+	It("indexes the secret of the context in the namespace of the operator", func() {
+		context := &v1alpha1.ClusterManagementContext{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared-ctx"},
+			Spec: v1alpha1.ClusterManagementContextSpec{Context: &management.Context{
+				Auth: &management.Auth{SecretRef: &refs.NamespacedName{Name: "apim-credentials", Namespace: "team-a"}},
+			}},
+		}
+
+		Expect(index(indexer.ClusterContextSecretField, context)).To(ConsistOf("gko-system/apim-credentials"))
+	})
+
+	It("indexes nothing without a secret", func() {
+		context := &v1alpha1.ClusterManagementContext{
+			Spec: v1alpha1.ClusterManagementContextSpec{Context: &management.Context{
+				Auth: &management.Auth{BearerToken: "token"},
+			}},
+		}
+
+		Expect(index(indexer.ClusterContextSecretField, context)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,48 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/portaltheme"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Portal theme indexers", func() {
+	theme := &v1alpha1.PortalTheme{
+		ObjectMeta: metav1.ObjectMeta{Name: "portal", Namespace: "default"},
+		Spec: v1alpha1.PortalThemeSpec{Type: portaltheme.Type{
+			CustomCSSFrom: &refs.ValueFrom{ConfigMapKeyRef: &refs.KeyRef{Name: "styles", Key: "custom.css"}},
+			Logo:          &refs.ValueFrom{SecretKeyRef: &refs.KeyRef{Name: "branding", Key: "logo.png"}},
+			Favicon:       &refs.ValueFrom{ConfigMapKeyRef: &refs.KeyRef{Name: "icons", Key: "favicon.ico"}},
+		}},
+	}
+
+	It("indexes the config maps holding the custom CSS and the favicon", func() {
+		Expect(index(indexer.PortalThemeConfigMapField, theme)).To(ConsistOf("default/styles", "default/icons"))
+	})
+
+	It("indexes the secret holding the logo", func() {
+		Expect(index(indexer.PortalThemeSecretField, theme)).To(ConsistOf("default/branding"))
+	})
+
+	It("indexes nothing without sources", func() {
+		Expect(index(indexer.PortalThemeSecretField, &v1alpha1.PortalTheme{})).To(BeEmpty())
+	})
+})
@@ -0,0 +1,57 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"testing"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/dictionary"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/indexer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIndexer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "package indexer")
+}
+
+var _ = Describe("Dictionary indexers", func() {
+	dict := &v1alpha1.Dictionary{
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default"},
+		Spec: v1alpha1.DictionarySpec{Type: dictionary.Type{
+			PropertiesFrom: []*dictionary.PropertiesSource{
+				{ConfigMap: &refs.NamespacedName{Name: "defaults"}},
+				{ConfigMap: &refs.NamespacedName{Name: "overrides", Namespace: "shared"}},
+				{Secret: &refs.NamespacedName{Name: "credentials"}},
+			},
+		}},
+	}
+
+	It("indexes the referenced config maps in the namespace of the dictionary", func() {
+		Expect(index(indexer.DictionaryConfigMapField, dict)).To(ConsistOf("default/defaults", "default/overrides"))
+	})
+
+	It("indexes the referenced secrets", func() {
+		Expect(index(indexer.DictionarySecretField, dict)).To(ConsistOf("default/credentials"))
+	})
+
+	It("indexes nothing without sources", func() {
+		Expect(index(indexer.DictionaryConfigMapField, &v1alpha1.Dictionary{})).To(BeEmpty())
+	})
+})
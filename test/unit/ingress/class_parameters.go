@@ -0,0 +1,153 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	netV1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const paramsNamespace = "gravitee"
+
+func newIngressClass(name string, params *netV1.IngressClassParametersReference) *netV1.IngressClass {
+	return &netV1.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       netV1.IngressClassSpec{Controller: "gravitee.io/operator", Parameters: params},
+	}
+}
+
+func graviteeParameters(namespace *string) *netV1.IngressClassParametersReference {
+	group := core.CRDGroup
+	return &netV1.IngressClassParametersReference{
+		APIGroup:  &group,
+		Kind:      core.CRDIngressClassParametersKind,
+		Name:      "params",
+		Namespace: namespace,
+		Scope:     ptr("Namespace"),
+	}
+}
+
+func newIngress(className string) *netV1.Ingress {
+	return &netV1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "httpbin", Namespace: "default"},
+		Spec:       netV1.IngressSpec{IngressClassName: &className},
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+func registerFakeClient(objects ...client.Object) {
+	scheme := runtime.NewScheme()
+	Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	k8s.RegisterClient(fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&netV1.Ingress{}).
+		Build())
+}
+
+var _ = Describe("Ingress class parameters", func() {
+	ctx := context.Background()
+
+	params := &v1alpha1.GraviteeIngressClassParameters{
+		ObjectMeta: metav1.ObjectMeta{Name: "params", Namespace: paramsNamespace},
+		Spec:       v1alpha1.GraviteeIngressClassParametersSpec{GatewayAddress: "10.0.0.1"},
+	}
+
+	AfterEach(func() {
+		k8s.RegisterClient(nil)
+	})
+
+	It("should match an ingress of a configured class", func() {
+		registerFakeClient()
+		Expect(k8s.IsGraviteeIngress(ctx, newIngress(core.IngressClassAnnotationValue))).To(BeTrue())
+	})
+
+	It("should match an ingress of a class referencing Gravitee parameters", func() {
+		registerFakeClient(newIngressClass("gravitee-dev", graviteeParameters(ptr(paramsNamespace))), params)
+
+		ingress := newIngress("gravitee-dev")
+		Expect(k8s.IsGraviteeIngress(ctx, ingress)).To(BeTrue())
+		Expect(k8s.HasConfiguredIngressClass(ingress)).To(BeFalse())
+
+		resolved, err := k8s.GetIngressClassParameters(ctx, ingress)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved.Spec.GatewayAddress).To(Equal("10.0.0.1"))
+	})
+
+	It("should not match an ingress of a class referencing other parameters", func() {
+		other := &netV1.IngressClassParametersReference{APIGroup: ptr("k8s.example.com"), Kind: "Parameters", Name: "params"}
+		registerFakeClient(newIngressClass("nginx", other))
+
+		ingress := newIngress("nginx")
+		Expect(k8s.IsGraviteeIngress(ctx, ingress)).To(BeFalse())
+
+		resolved, err := k8s.GetIngressClassParameters(ctx, ingress)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved).To(BeNil())
+	})
+
+	It("should not match an ingress of an unknown class", func() {
+		registerFakeClient()
+		Expect(k8s.IsGraviteeIngress(ctx, newIngress("unknown"))).To(BeFalse())
+	})
+
+	It("should require a namespace scope for the parameters", func() {
+		registerFakeClient(newIngressClass("gravitee-dev", graviteeParameters(nil)), params)
+
+		_, err := k8s.GetIngressClassParameters(ctx, newIngress("gravitee-dev"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("should report the gateway address in the ingress status",
+		func(address string, expected netV1.IngressLoadBalancerIngress) {
+			withAddress := params.DeepCopy()
+			withAddress.Spec.GatewayAddress = address
+			ingress := newIngress("gravitee-dev")
+			registerFakeClient(newIngressClass("gravitee-dev", graviteeParameters(ptr(paramsNamespace))), withAddress, ingress)
+
+			Expect(k8s.UpdateIngressStatus(ctx, ingress)).To(Succeed())
+
+			updated := &netV1.Ingress{}
+			Expect(k8s.GetClient().Get(ctx, client.ObjectKeyFromObject(ingress), updated)).To(Succeed())
+			Expect(updated.Status.LoadBalancer.Ingress).To(Equal([]netV1.IngressLoadBalancerIngress{expected}))
+		},
+		Entry("with an IP", "10.0.0.1", netV1.IngressLoadBalancerIngress{IP: "10.0.0.1"}),
+		Entry("with a host name", "gateway.example.com", netV1.IngressLoadBalancerIngress{Hostname: "gateway.example.com"}),
+	)
+
+	It("should leave the ingress status unchanged without parameters", func() {
+		ingress := newIngress(core.IngressClassAnnotationValue)
+		registerFakeClient(ingress)
+
+		Expect(k8s.UpdateIngressStatus(ctx, ingress)).To(Succeed())
+		Expect(ingress.Status.LoadBalancer.Ingress).To(BeEmpty())
+	})
+})
@@ -48,13 +48,21 @@ var _ = Describe("Gravitee gateway config", func() {
 			},
 			nil,
 		),
+		Entry(
+			"with valid pkcs12 config", gateway.KeystoreConfig{
+				Type:     "pkcs12",
+				Location: location,
+				Password: "password",
+			},
+			nil,
+		),
 		Entry(
 			"with wrong type", gateway.KeystoreConfig{
 				Type:     "pem",
 				Location: location,
 				Password: "password",
 			},
-			fmt.Errorf("expected keystore type jks, got pem"),
+			fmt.Errorf("expected keystore type jks or pkcs12, got pem"),
 		),
 		Entry(
 			"with wrong kubernetes location path", gateway.KeystoreConfig{
@@ -0,0 +1,154 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	v2 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v2"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/admission/ingress"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/ingress/mapper"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coreV1 "k8s.io/api/core/v1"
+	netV1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Ingress mapper", func() {
+	const host = "httpbin.example.com"
+
+	It("should map each path to an endpoint of the default group", func() {
+		ing := newRoutedIngress("httpbin", host, "/get", "httpbin")
+
+		api := mapper.New(mapper.NewOpts()).Map(newApiTemplate(), ing)
+
+		Expect(api.Spec.Proxy.Groups).To(HaveLen(1))
+		group := api.Spec.Proxy.Groups[0]
+		Expect(group.Name).To(Equal("default"))
+		Expect(group.Endpoints).To(HaveLen(1))
+		Expect(group.Endpoints[0].Name).To(Equal("rule01-path01"))
+		Expect(group.Endpoints[0].Target).To(Equal("http://httpbin.default.svc.cluster.local:8080"))
+	})
+
+	It("should split the traffic of a path with a canary", func() {
+		ing := newRoutedIngress("httpbin", host, "/get", "httpbin")
+		canary := newRoutedIngress("httpbin-canary", host, "/get", "httpbin-v2")
+
+		opts := mapper.NewOpts()
+		opts.Canaries = []mapper.Canary{{Ingress: canary, Weight: 20}}
+		api := mapper.New(opts).Map(newApiTemplate(), ing)
+
+		Expect(api.Spec.Proxy.Groups).To(HaveLen(1))
+		group := api.Spec.Proxy.Groups[0]
+		Expect(group.Name).To(Equal("rule01-path01"))
+		Expect(group.LoadBalancer.Type).To(Equal(v2.WeightedRoundRobin))
+		Expect(group.Endpoints).To(HaveLen(2))
+		Expect(group.Endpoints[0].Name).To(Equal("rule01-path01-stable"))
+		Expect(group.Endpoints[0].Weight).To(Equal(80))
+		Expect(group.Endpoints[1].Name).To(Equal("rule01-path01-canary"))
+		Expect(group.Endpoints[1].Target).To(Equal("http://httpbin-v2.default.svc.cluster.local:8080"))
+		Expect(group.Endpoints[1].Weight).To(Equal(20))
+	})
+
+	It("should route all the traffic to a canary with a full weight", func() {
+		ing := newRoutedIngress("httpbin", host, "/get", "httpbin")
+		canary := newRoutedIngress("httpbin-canary", host, "/get", "httpbin-v2")
+
+		opts := mapper.NewOpts()
+		opts.Canaries = []mapper.Canary{{Ingress: canary, Weight: 100}}
+		api := mapper.New(opts).Map(newApiTemplate(), ing)
+
+		group := api.Spec.Proxy.Groups[0]
+		Expect(group.Endpoints).To(HaveLen(1))
+		Expect(group.Endpoints[0].Name).To(Equal("rule01-path01-canary"))
+	})
+
+	It("should ignore canaries without weight or for other paths", func() {
+		ing := newRoutedIngress("httpbin", host, "/get", "httpbin")
+
+		opts := mapper.NewOpts()
+		opts.Canaries = []mapper.Canary{
+			{Ingress: newRoutedIngress("no-weight", host, "/get", "httpbin-v2"), Weight: 0},
+			{Ingress: newRoutedIngress("other-path", host, "/post", "httpbin-v2"), Weight: 50},
+		}
+		api := mapper.New(opts).Map(newApiTemplate(), ing)
+
+		Expect(api.Spec.Proxy.Groups).To(HaveLen(1))
+		Expect(api.Spec.Proxy.Groups[0].Name).To(Equal("default"))
+	})
+})
+
+var _ = Describe("Canary ingress admission", func() {
+	AfterEach(func() {
+		k8s.RegisterClient(nil)
+	})
+
+	It("should warn when a canary has no weight", func() {
+		registerFakeClient(newService("httpbin-v2"))
+		canary := newRoutedIngress("httpbin-canary", "httpbin.example.com", "/get", "httpbin-v2")
+		canary.Annotations = map[string]string{core.IngressCanaryAnnotation: "true"}
+
+		warnings, err := ingress.AdmissionCtrl{}.ValidateCreate(context.Background(), canary)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(ContainElement(ContainSubstring(core.IngressCanaryWeightAnnotation)))
+	})
+
+	It("should reject a canary with an invalid weight", func() {
+		registerFakeClient(newService("httpbin-v2"))
+		canary := newRoutedIngress("httpbin-canary", "httpbin.example.com", "/get", "httpbin-v2")
+		canary.Annotations = map[string]string{
+			core.IngressCanaryAnnotation:       "true",
+			core.IngressCanaryWeightAnnotation: "120",
+		}
+
+		_, err := ingress.AdmissionCtrl{}.ValidateCreate(context.Background(), canary)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+func newRoutedIngress(name, host, path, service string) *netV1.Ingress {
+	ing := newIngress(core.IngressClassAnnotationValue)
+	ing.Name = name
+	pathType := netV1.PathTypePrefix
+	ing.Spec.Rules = []netV1.IngressRule{{
+		Host: host,
+		IngressRuleValue: netV1.IngressRuleValue{HTTP: &netV1.HTTPIngressRuleValue{
+			Paths: []netV1.HTTPIngressPath{{
+				Path:     path,
+				PathType: &pathType,
+				Backend: netV1.IngressBackend{Service: &netV1.IngressServiceBackend{
+					Name: service,
+					Port: netV1.ServiceBackendPort{Number: 8080},
+				}},
+			}},
+		}},
+	}}
+	return ing
+}
+
+func newApiTemplate() *v1alpha1.ApiDefinition {
+	return &v1alpha1.ApiDefinition{
+		Spec: v1alpha1.ApiDefinitionV2Spec{Api: v2.Api{ApiBase: &base.ApiBase{}}},
+	}
+}
+
+func newService(name string) *coreV1.Service {
+	return &coreV1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keystore_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"time"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/keystore"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	jks "github.com/pavlo-v-chernykh/keystore-go/v4"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+var _ = Describe("Keystore encoder", func() {
+	const password = "changeit"
+
+	It("should encode a JKS keystore", func() {
+		entries := []keystore.Entry{newEntry("foo.example.com"), newEntry("bar.example.com")}
+
+		data, err := keystore.NewEncoder().Encode("JKS", entries, password)
+		Expect(err).ToNot(HaveOccurred())
+
+		store := jks.New()
+		Expect(store.Load(bytes.NewReader(data), []byte(password))).To(Succeed())
+		Expect(store.Aliases()).To(ConsistOf("foo.example.com", "bar.example.com"))
+
+		for _, entry := range entries {
+			decoded, gErr := store.GetPrivateKeyEntry(entry.Alias, []byte(password))
+			Expect(gErr).ToNot(HaveOccurred())
+			Expect(decoded.CertificateChain).To(HaveLen(1))
+			Expect(decoded.CertificateChain[0].Content).To(Equal(entry.Chain[0].Raw))
+
+			key, pErr := x509.ParsePKCS8PrivateKey(decoded.PrivateKey)
+			Expect(pErr).ToNot(HaveOccurred())
+			Expect(key).To(Equal(entry.Key))
+		}
+	})
+
+	It("should not load a JKS keystore with a wrong password", func() {
+		data, err := keystore.NewEncoder().Encode("jks", []keystore.Entry{newEntry("foo")}, password)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(jks.New().Load(bytes.NewReader(data), []byte("wrong"))).ToNot(Succeed())
+	})
+
+	It("should encode a PKCS12 keystore", func() {
+		entry := newEntry("foo.example.com")
+
+		data, err := keystore.NewEncoder().Encode("pkcs12", []keystore.Entry{entry}, password)
+		Expect(err).ToNot(HaveOccurred())
+
+		key, cert, caCerts, err := pkcs12.DecodeChain(data, password)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(key).To(Equal(entry.Key))
+		Expect(cert.Raw).To(Equal(entry.Chain[0].Raw))
+		Expect(caCerts).To(BeEmpty())
+
+		_, _, _, err = pkcs12.DecodeChain(data, "wrong")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should collapse PKCS12 entries sharing the same certificate", func() {
+		entry := newEntry("foo.example.com")
+		alias := entry
+		alias.Alias = "bar.example.com"
+
+		data, err := keystore.NewEncoder().Encode("pkcs12", []keystore.Entry{entry, alias}, password)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, cert, _, err := pkcs12.DecodeChain(data, password)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert.Raw).To(Equal(entry.Chain[0].Raw))
+	})
+
+	It("should reject PKCS12 keystores with several private keys", func() {
+		entries := []keystore.Entry{newEntry("foo.example.com"), newEntry("bar.example.com")}
+
+		_, err := keystore.NewEncoder().Encode("pkcs12", entries, password)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject unsupported keystore types", func() {
+		_, err := keystore.NewEncoder().Encode("pem", []keystore.Entry{newEntry("foo")}, password)
+		Expect(err).To(MatchError("unsupported keystore type pem"))
+	})
+
+	It("should reject entries without certificate", func() {
+		_, err := keystore.NewEncoder().Encode("jks", []keystore.Entry{{Alias: "foo"}}, password)
+		Expect(err).To(MatchError("keystore entry [foo] has no certificate"))
+	})
+})
+
+func newEntry(host string) keystore.Entry {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+
+	return keystore.Entry{Alias: host, Key: key, Chain: []*x509.Certificate{cert}}
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/openapi"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOpenAPI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "package openapi")
+}
+
+const petstore = `
+openapi: 3.0.3
+info:
+  title: Petstore
+  version: "1.0"
+servers:
+  - url: https://api.example.com/petstore/
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          description: OK
+    post:
+      responses:
+        "201":
+          description: Created
+  /pets/{petId}:
+    get:
+      operationId: showPetById
+      parameters:
+        - name: petId
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        "200":
+          description: OK
+`
+
+var _ = Describe("Generate", func() {
+	generate := func(api *v4.Api) error {
+		raw, doc, err := openapi.Parse([]byte(petstore))
+		Expect(err).ToNot(HaveOccurred())
+		spec, err := openapi.Load(context.Background(), doc, raw)
+		Expect(err).ToNot(HaveOccurred())
+		return openapi.Generate(api, petstore, openapi.ServerPaths(doc, spec), spec)
+	}
+
+	newAPI := func(oas *v4.OpenAPI) *v4.Api {
+		return &v4.Api{ApiBase: &base.ApiBase{}, OpenAPI: oas}
+	}
+
+	It("generates listeners, endpoints, flows and page from the specification", func() {
+		api := newAPI(&v4.OpenAPI{Backend: "https://backend.example.com"})
+		Expect(generate(api)).To(Succeed())
+
+		Expect(api.Listeners).To(HaveLen(1))
+		listener, ok := api.Listeners[0].ToListener().(*v4.HttpListener)
+		Expect(ok).To(BeTrue())
+		Expect(listener.Paths).To(HaveLen(1))
+		Expect(listener.Paths[0].Path).To(Equal("/petstore/"))
+
+		Expect(api.EndpointGroups).To(HaveLen(1))
+		Expect(api.EndpointGroups[0].Endpoints[0].Config.GetString("target")).To(Equal("https://backend.example.com"))
+
+		Expect(api.Flows).To(HaveLen(3))
+		Expect(api.Flows[0].Name).To(Equal("listPets"))
+		Expect(api.Flows[1].Name).To(Equal("POST /pets"))
+		Expect(api.Flows[2].Name).To(Equal("showPetById"))
+		Expect(api.Flows[2].Selectors[0].GetString("path")).To(Equal("/pets/:petId"))
+		Expect(api.Flows[2].Request).To(BeEmpty())
+
+		Expect(api.Pages).To(HaveKey(openapi.DefaultPageKey))
+		Expect(api.Pages[openapi.DefaultPageKey].Name).To(Equal("Petstore"))
+		Expect(api.Pages[openapi.DefaultPageKey].Type).To(Equal(base.SwaggerPageType))
+	})
+
+	It("adds a request validation policy backed by the specification", func() {
+		api := newAPI(&v4.OpenAPI{Backend: "https://backend.example.com", ValidateRequest: true})
+		Expect(generate(api)).To(Succeed())
+
+		Expect(api.Resources).To(HaveLen(1))
+		Expect(api.Resources[0].Configuration.GetString("content")).To(Equal(petstore))
+		for _, flow := range api.Flows {
+			Expect(flow.Request).To(HaveLen(1))
+			Expect(flow.Request[0].Policy).To(Equal("oas-validation"))
+		}
+	})
+
+	It("keeps declared flows and pages", func() {
+		api := newAPI(&v4.OpenAPI{Backend: "https://backend.example.com", PageKey: "spec"})
+		api.Flows = []*v4.Flow{v4.NewFlow("listPets")}
+		api.Pages = map[string]*v4.Page{"spec": {Page: &base.Page{Name: "custom", Type: base.SwaggerPageType}}}
+		Expect(generate(api)).To(Succeed())
+
+		Expect(api.Flows).To(HaveLen(3))
+		Expect(api.Flows[0].Selectors).To(BeEmpty())
+		Expect(api.Pages["spec"].Name).To(Equal("custom"))
+	})
+
+	It("requires a backend when no endpoint group is declared", func() {
+		Expect(generate(newAPI(&v4.OpenAPI{}))).ToNot(Succeed())
+	})
+})
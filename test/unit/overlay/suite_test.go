@@ -0,0 +1,127 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overlay
+
+import (
+	"testing"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/management"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/utils"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/core"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/overlay"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOverlay(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "package overlay")
+}
+
+func newAPI() *v1alpha1.ApiV4Definition {
+	config := utils.NewGenericStringMap()
+	config.Put("target", "https://backend.dev.svc")
+	return &v1alpha1.ApiV4Definition{
+		Spec: v1alpha1.ApiV4DefinitionSpec{
+			Api: v4.Api{
+				ApiBase: &base.ApiBase{Name: "echo", Version: "1.0", Tags: []string{"dev"}},
+				Type:    v4.ProxyType,
+				EndpointGroups: []*v4.EndpointGroup{
+					{
+						Name: "default",
+						Type: "http-proxy",
+						Endpoints: []*v4.Endpoint{
+							{Name: "default", Type: "http-proxy", Config: config},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newContext(patches ...management.ApiPatch) *management.Context {
+	return &management.Context{ApiPatches: patches}
+}
+
+var _ = Describe("Apply", func() {
+	It("applies merge patches to the API definition", func() {
+		api := newAPI()
+		err := overlay.Apply(newContext(management.ApiPatch{
+			Type:  management.MergePatchType,
+			Patch: "tags: [production]\ndescription: patched",
+		}), api)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(api.Spec.Tags).To(Equal([]string{"production"}))
+		Expect(api.Spec.Description).To(Equal("patched"))
+		Expect(api.Spec.Name).To(Equal("echo"))
+	})
+
+	It("applies JSON patches in order", func() {
+		api := newAPI()
+		err := overlay.Apply(newContext(
+			management.ApiPatch{
+				Type:  management.JSONPatchType,
+				Patch: `[{"op": "add", "path": "/tags/-", "value": "production"}]`,
+			},
+			management.ApiPatch{
+				Type: management.JSONPatchType,
+				Patch: `
+- op: replace
+  path: /endpointGroups/0/endpoints/0/configuration/target
+  value: https://backend.prod.svc`,
+			},
+		), api)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(api.Spec.Tags).To(Equal([]string{"dev", "production"}))
+		Expect(api.Spec.EndpointGroups[0].Endpoints[0].Config.Get("target")).To(Equal("https://backend.prod.svc"))
+	})
+
+	It("skips patches targeting another definition version", func() {
+		api := newAPI()
+		err := overlay.Apply(newContext(management.ApiPatch{
+			Patch:             "tags: [production]",
+			DefinitionVersion: string(core.ApiV2),
+		}), api)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(api.Spec.Tags).To(Equal([]string{"dev"}))
+	})
+
+	It("fails when a patch cannot be applied", func() {
+		api := newAPI()
+		err := overlay.Apply(newContext(management.ApiPatch{
+			Type:  management.JSONPatchType,
+			Patch: `[{"op": "replace", "path": "/missing/0", "value": "x"}]`,
+		}), api)
+
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Validate", func() {
+	It("rejects merge patches that are not objects", func() {
+		Expect(overlay.Validate(&management.ApiPatch{Patch: "- tags"})).To(HaveOccurred())
+	})
+
+	It("rejects JSON patches that are not operations", func() {
+		Expect(overlay.Validate(&management.ApiPatch{Type: management.JSONPatchType, Patch: "tags: []"})).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,103 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pages
+
+import (
+	"context"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	v4 "github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/v4"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/refs"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/v1alpha1"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/k8s"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/pages"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Resolve", func() {
+	ctx := context.Background()
+
+	newAPI := func(page *base.Page) *v1alpha1.ApiV4Definition {
+		return &v1alpha1.ApiV4Definition{
+			ObjectMeta: metav1.ObjectMeta{Name: "petstore", Namespace: "default"},
+			Spec: v1alpha1.ApiV4DefinitionSpec{Api: v4.Api{
+				ApiBase: &base.ApiBase{Name: "petstore"},
+				Pages:   map[string]*v4.Page{"docs": {Page: page}},
+			}},
+		}
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+
+		k8s.RegisterClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&coreV1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "docs", Namespace: "default"},
+				Data:       map[string]string{"guide.md": "# Guide"},
+			},
+			&coreV1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "docs", Namespace: "kube-system"},
+				Data:       map[string]string{"cluster.md": "# Cluster"},
+			},
+			&coreV1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "docs", Namespace: "default"},
+				Data:       map[string][]byte{"readme.md": []byte("# Readme")},
+			},
+		).Build())
+	})
+
+	AfterEach(func() {
+		k8s.RegisterClient(nil)
+	})
+
+	It("reads the content of a page from the namespace of the API", func() {
+		api := newAPI(&base.Page{
+			Name:        "readme",
+			Type:        base.MarkdownPageType,
+			ContentFrom: &refs.ValueFrom{SecretKeyRef: &refs.KeyRef{Name: "docs", Key: "readme.md"}},
+		})
+
+		Expect(pages.Resolve(ctx, api)).To(Succeed())
+		Expect(api.Spec.Pages["docs"].Content).To(Equal("# Readme"))
+	})
+
+	It("expands a folder from a config map of the namespace of the API", func() {
+		api := newAPI(&base.Page{Name: "docs", Type: base.FolderPageType, PagesFrom: &refs.NamespacedName{Name: "docs"}})
+
+		Expect(pages.Resolve(ctx, api)).To(Succeed())
+		Expect(api.Spec.Pages).To(HaveKey("docs/guide"))
+		Expect(api.Spec.Pages["docs/guide"].Content).To(Equal("# Guide"))
+	})
+
+	It("rejects a folder expanded from a config map of another namespace", func() {
+		api := newAPI(&base.Page{
+			Name:      "docs",
+			Type:      base.FolderPageType,
+			PagesFrom: &refs.NamespacedName{Name: "docs", Namespace: "kube-system"},
+		})
+
+		Expect(pages.Resolve(ctx, api)).To(MatchError(
+			"config map of page [docs] must be in the namespace of the API [default], got [kube-system]",
+		))
+		Expect(api.Spec.Pages).ToNot(HaveKey("docs/cluster"))
+	})
+})
@@ -0,0 +1,109 @@
+// Copyright (C) 2015 The Gravitee team (http://gravitee.io)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pages
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/api/base"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/api/model/utils"
+	"github.com/gravitee-io/gravitee-kubernetes-operator/internal/pages"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPages(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "package pages")
+}
+
+var _ = Describe("Expand", func() {
+	folder := &base.Page{Name: "docs", Type: base.FolderPageType, Published: true, Visibility: "PRIVATE"}
+
+	It("expands config map entries into a page tree", func() {
+		expanded := pages.Expand("docs", folder, map[string]string{
+			"index.md":                    "# Welcome",
+			"guides__getting-started.md":  "# Getting started",
+			"guides__advanced__tuning.md": "# Tuning",
+			"openapi.yaml":                "openapi: 3.0.0",
+			"notes.txt":                   "ignored",
+		})
+
+		Expect(expanded).To(HaveLen(6))
+		Expect(expanded).To(HaveKey("docs/guides"))
+		Expect(expanded).To(HaveKey("docs/guides/advanced"))
+		Expect(expanded["docs/guides"].Type).To(Equal(base.FolderPageType))
+		Expect(expanded["docs/guides/advanced"].Parent).To(Equal("docs/guides"))
+
+		tuning := expanded["docs/guides/advanced/tuning"]
+		Expect(tuning.Name).To(Equal("tuning"))
+		Expect(tuning.Type).To(Equal(base.MarkdownPageType))
+		Expect(tuning.Parent).To(Equal("docs/guides/advanced"))
+		Expect(tuning.Content).To(Equal("# Tuning"))
+		Expect(tuning.Published).To(BeTrue())
+		Expect(tuning.Visibility).To(Equal("PRIVATE"))
+
+		Expect(expanded["docs/index"].Parent).To(Equal("docs"))
+		Expect(expanded["docs/openapi"].Type).To(Equal(base.SwaggerPageType))
+	})
+})
+
+var _ = Describe("TypeOf", func() {
+	It("tells OpenAPI and AsyncAPI specifications apart", func() {
+		Expect(pages.TypeOf("spec.json", `{"openapi": "3.0.0"}`)).To(Equal(base.SwaggerPageType))
+		Expect(pages.TypeOf("spec.yml", "asyncapi: 2.6.0")).To(Equal(base.AsyncAPIPageType))
+		Expect(pages.TypeOf("guide.adoc", "= Guide")).To(Equal(base.AsciidocPageType))
+		Expect(pages.TypeOf("image.png", "")).To(BeEmpty())
+	})
+})
+
+var _ = Describe("NewServiceSource", func() {
+	It("defaults to the namespace of the API and to the conventional document location", func() {
+		source, err := pages.NewServiceSource("default", utils.NewGenericStringMap().Put("serviceName", "petstore"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(source.URL()).To(Equal("http://petstore.default.svc:80/openapi.json"))
+		Expect(source.FetchInterval).To(Equal(5 * time.Minute))
+	})
+
+	It("reads the location of the document and the fetch interval", func() {
+		source, err := pages.NewServiceSource("apps", utils.NewGenericStringMap().
+			Put("serviceName", "petstore").
+			Put("serviceNamespace", "apps").
+			Put("port", 8080).
+			Put("path", "v3/api-docs").
+			Put("fetchInterval", "30s"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(source.URL()).To(Equal("http://petstore.apps.svc:8080/v3/api-docs"))
+		Expect(source.FetchInterval).To(Equal(30 * time.Second))
+	})
+
+	It("rejects services of another namespace than the one of the API", func() {
+		_, err := pages.NewServiceSource("default", utils.NewGenericStringMap().
+			Put("serviceName", "petstore").Put("serviceNamespace", "kube-system"))
+		Expect(err).To(MatchError("serviceNamespace must be the namespace of the API [default], got [kube-system]"))
+	})
+
+	It("rejects invalid configurations", func() {
+		_, err := pages.NewServiceSource("default", utils.NewGenericStringMap().Put("port", 8080))
+		Expect(err).To(HaveOccurred())
+		_, err = pages.NewServiceSource("default", utils.NewGenericStringMap().
+			Put("serviceName", "petstore").Put("port", "http"))
+		Expect(err).To(HaveOccurred())
+		_, err = pages.NewServiceSource("default", utils.NewGenericStringMap().
+			Put("serviceName", "petstore").Put("fetchInterval", "every minute"))
+		Expect(err).To(HaveOccurred())
+	})
+})